@@ -0,0 +1,83 @@
+// Package anthropic adapts github.com/anthropics/anthropic-sdk-go message params to and
+// from Lingua's typed message model, so users of that client can route messages through
+// Lingua without hand-marshaling maps.
+package anthropic
+
+import (
+	"encoding/json"
+	"fmt"
+
+	anthropicsdk "github.com/anthropics/anthropic-sdk-go"
+
+	"github.com/braintrustdata/lingua/bindings/golang"
+)
+
+// ToLingua converts Anthropic SDK message params to Lingua messages.
+func ToLingua(messages []anthropicsdk.MessageParam) ([]lingua.Message, error) {
+	raw := make([]map[string]any, 0, len(messages))
+	for _, msg := range messages {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return nil, fmt.Errorf("lingua/adapters/anthropic: failed to marshal message: %w", err)
+		}
+		var m map[string]any
+		if err := json.Unmarshal(data, &m); err != nil {
+			return nil, fmt.Errorf("lingua/adapters/anthropic: failed to unmarshal message: %w", err)
+		}
+		raw = append(raw, m)
+	}
+
+	linguaMsgs, err := lingua.AnthropicMessagesToLingua(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]lingua.Message, 0, len(linguaMsgs))
+	for _, m := range linguaMsgs {
+		data, err := json.Marshal(m)
+		if err != nil {
+			return nil, fmt.Errorf("lingua/adapters/anthropic: failed to marshal lingua message: %w", err)
+		}
+		var msg lingua.Message
+		if err := json.Unmarshal(data, &msg); err != nil {
+			return nil, fmt.Errorf("lingua/adapters/anthropic: failed to unmarshal lingua message: %w", err)
+		}
+		result = append(result, msg)
+	}
+	return result, nil
+}
+
+// FromLingua converts Lingua messages to Anthropic SDK message params.
+func FromLingua(messages []lingua.Message) ([]anthropicsdk.MessageParam, error) {
+	raw := make([]map[string]any, 0, len(messages))
+	for _, msg := range messages {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return nil, fmt.Errorf("lingua/adapters/anthropic: failed to marshal lingua message: %w", err)
+		}
+		var m map[string]any
+		if err := json.Unmarshal(data, &m); err != nil {
+			return nil, fmt.Errorf("lingua/adapters/anthropic: failed to unmarshal lingua message: %w", err)
+		}
+		raw = append(raw, m)
+	}
+
+	anthropicMsgs, err := lingua.LinguaToAnthropicMessages(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]anthropicsdk.MessageParam, 0, len(anthropicMsgs))
+	for _, m := range anthropicMsgs {
+		data, err := json.Marshal(m)
+		if err != nil {
+			return nil, fmt.Errorf("lingua/adapters/anthropic: failed to marshal message: %w", err)
+		}
+		var msg anthropicsdk.MessageParam
+		if err := json.Unmarshal(data, &msg); err != nil {
+			return nil, fmt.Errorf("lingua/adapters/anthropic: failed to unmarshal message: %w", err)
+		}
+		result = append(result, msg)
+	}
+	return result, nil
+}
@@ -0,0 +1,22 @@
+package anthropic
+
+import (
+	"testing"
+
+	anthropicsdk "github.com/anthropics/anthropic-sdk-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToLinguaAndBack(t *testing.T) {
+	messages := []anthropicsdk.MessageParam{
+		anthropicsdk.NewUserMessage(anthropicsdk.NewTextBlock("Hello")),
+	}
+
+	linguaMsgs, err := ToLingua(messages)
+	require.NoError(t, err)
+	require.Len(t, linguaMsgs, 1)
+
+	back, err := FromLingua(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, back, 1)
+}
@@ -0,0 +1,83 @@
+// Package openai adapts github.com/sashabaranov/go-openai chat messages to and from
+// Lingua's typed message model, so users of that client can route messages through
+// Lingua without hand-marshaling maps.
+package openai
+
+import (
+	"encoding/json"
+	"fmt"
+
+	openai "github.com/sashabaranov/go-openai"
+
+	"github.com/braintrustdata/lingua/bindings/golang"
+)
+
+// ToLingua converts go-openai chat messages to Lingua messages.
+func ToLingua(messages []openai.ChatCompletionMessage) ([]lingua.Message, error) {
+	raw := make([]map[string]any, 0, len(messages))
+	for _, msg := range messages {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return nil, fmt.Errorf("lingua/adapters/openai: failed to marshal message: %w", err)
+		}
+		var m map[string]any
+		if err := json.Unmarshal(data, &m); err != nil {
+			return nil, fmt.Errorf("lingua/adapters/openai: failed to unmarshal message: %w", err)
+		}
+		raw = append(raw, m)
+	}
+
+	linguaMsgs, err := lingua.ChatCompletionsMessagesToLingua(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]lingua.Message, 0, len(linguaMsgs))
+	for _, m := range linguaMsgs {
+		data, err := json.Marshal(m)
+		if err != nil {
+			return nil, fmt.Errorf("lingua/adapters/openai: failed to marshal lingua message: %w", err)
+		}
+		var msg lingua.Message
+		if err := json.Unmarshal(data, &msg); err != nil {
+			return nil, fmt.Errorf("lingua/adapters/openai: failed to unmarshal lingua message: %w", err)
+		}
+		result = append(result, msg)
+	}
+	return result, nil
+}
+
+// FromLingua converts Lingua messages to go-openai chat messages.
+func FromLingua(messages []lingua.Message) ([]openai.ChatCompletionMessage, error) {
+	raw := make([]map[string]any, 0, len(messages))
+	for _, msg := range messages {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return nil, fmt.Errorf("lingua/adapters/openai: failed to marshal lingua message: %w", err)
+		}
+		var m map[string]any
+		if err := json.Unmarshal(data, &m); err != nil {
+			return nil, fmt.Errorf("lingua/adapters/openai: failed to unmarshal lingua message: %w", err)
+		}
+		raw = append(raw, m)
+	}
+
+	chatMsgs, err := lingua.LinguaToChatCompletionsMessages(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]openai.ChatCompletionMessage, 0, len(chatMsgs))
+	for _, m := range chatMsgs {
+		data, err := json.Marshal(m)
+		if err != nil {
+			return nil, fmt.Errorf("lingua/adapters/openai: failed to marshal message: %w", err)
+		}
+		var msg openai.ChatCompletionMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			return nil, fmt.Errorf("lingua/adapters/openai: failed to unmarshal message: %w", err)
+		}
+		result = append(result, msg)
+	}
+	return result, nil
+}
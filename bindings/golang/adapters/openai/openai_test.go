@@ -0,0 +1,23 @@
+package openai
+
+import (
+	"testing"
+
+	openai "github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToLinguaAndBack(t *testing.T) {
+	messages := []openai.ChatCompletionMessage{
+		{Role: openai.ChatMessageRoleUser, Content: "Hello"},
+	}
+
+	linguaMsgs, err := ToLingua(messages)
+	require.NoError(t, err)
+	require.Len(t, linguaMsgs, 1)
+
+	back, err := FromLingua(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, back, 1)
+	require.Equal(t, "Hello", back[0].Content)
+}
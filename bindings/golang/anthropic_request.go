@@ -0,0 +1,123 @@
+package lingua
+
+import "errors"
+
+// AnthropicRequestOptions configures how system messages are lifted out of a Lingua
+// message slice when building an Anthropic request.
+type AnthropicRequestOptions struct {
+	// SystemSeparator joins multiple system messages' text together. Defaults to "\n\n".
+	SystemSeparator string
+	// Strict causes LinguaToAnthropicRequest to error if a system message appears after
+	// a non-system message, instead of silently lifting it out of order.
+	Strict bool
+}
+
+// LinguaToAnthropicRequest converts Lingua messages to Anthropic's Messages API shape,
+// lifting any `role:"system"` messages out of the messages array into Anthropic's
+// top-level `system` string, since Anthropic forbids `system` inside `messages`.
+func LinguaToAnthropicRequest(messages []map[string]any, opts AnthropicRequestOptions) (string, []map[string]any, error) {
+	separator := opts.SystemSeparator
+	if separator == "" {
+		separator = "\n\n"
+	}
+
+	systemParts, rest, err := extractSystemMessages(messages, opts.Strict)
+	if err != nil {
+		return "", nil, err
+	}
+
+	anthropicMsgs, err := LinguaToAnthropicMessages(rest)
+	if err != nil {
+		return "", nil, err
+	}
+
+	system := joinSystemParts(systemParts, separator)
+	return system, anthropicMsgs, nil
+}
+
+// AnthropicRequestToLingua converts an Anthropic request's top-level `system` field and
+// `messages` array back to Lingua messages, restoring the system text as synthetic
+// leading `role:"system"` message(s). A string `system` field restores to a single
+// message, matching LinguaToAnthropicRequest's string output. A block-array `system`
+// field restores one message per block, preserving the boundaries between the original
+// system messages instead of joining them into one.
+func AnthropicRequestToLingua(system any, messages []map[string]any) ([]map[string]any, error) {
+	linguaMsgs, err := AnthropicMessagesToLingua(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := systemFieldToParts(system)
+	if len(parts) == 0 {
+		return linguaMsgs, nil
+	}
+
+	systemMsgs := make([]map[string]any, 0, len(parts))
+	for _, part := range parts {
+		systemMsgs = append(systemMsgs, map[string]any{"role": "system", "content": part})
+	}
+	return append(systemMsgs, linguaMsgs...), nil
+}
+
+func extractSystemMessages(messages []map[string]any, strict bool) ([]string, []map[string]any, error) {
+	var systemParts []string
+	rest := make([]map[string]any, 0, len(messages))
+	seenNonSystem := false
+
+	for _, msg := range messages {
+		if msg["role"] == "system" {
+			if strict && seenNonSystem {
+				return nil, nil, errors.New("lingua: system message appears after a non-system message")
+			}
+			if text, ok := msg["content"].(string); ok {
+				systemParts = append(systemParts, text)
+			} else {
+				systemParts = append(systemParts, systemFieldToParts(msg["content"])...)
+			}
+			continue
+		}
+		seenNonSystem = true
+		rest = append(rest, msg)
+	}
+
+	return systemParts, rest, nil
+}
+
+func joinSystemParts(parts []string, separator string) string {
+	if len(parts) == 0 {
+		return ""
+	}
+	out := parts[0]
+	for _, p := range parts[1:] {
+		out += separator + p
+	}
+	return out
+}
+
+// systemFieldToParts extracts the system text part(s) from an Anthropic request's
+// `system` field, without joining them: a string field yields at most one part, a
+// block-array field yields one part per text block, preserving the original message
+// boundaries for AnthropicRequestToLingua to restore.
+func systemFieldToParts(system any) []string {
+	switch v := system.(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	case []any:
+		var parts []string
+		for _, block := range v {
+			m, ok := block.(map[string]any)
+			if !ok {
+				continue
+			}
+			if text, ok := m["text"].(string); ok && text != "" {
+				parts = append(parts, text)
+			}
+		}
+		return parts
+	default:
+		return nil
+	}
+}
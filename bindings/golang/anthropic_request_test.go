@@ -0,0 +1,87 @@
+package lingua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinguaToAnthropicRequestLiftsSystemMessage(t *testing.T) {
+	messages := []map[string]any{
+		{"role": "system", "content": "Be concise."},
+		{"role": "user", "content": "Hello"},
+	}
+
+	system, anthropicMsgs, err := LinguaToAnthropicRequest(messages, AnthropicRequestOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "Be concise.", system)
+	require.Len(t, anthropicMsgs, 1)
+	require.Equal(t, "user", anthropicMsgs[0]["role"])
+}
+
+func TestLinguaToAnthropicRequestConcatenatesMultipleSystemMessages(t *testing.T) {
+	messages := []map[string]any{
+		{"role": "system", "content": "Be concise."},
+		{"role": "system", "content": "Be polite."},
+		{"role": "user", "content": "Hello"},
+	}
+
+	system, _, err := LinguaToAnthropicRequest(messages, AnthropicRequestOptions{SystemSeparator: " "})
+	require.NoError(t, err)
+	require.Equal(t, "Be concise. Be polite.", system)
+}
+
+func TestLinguaToAnthropicRequestStrictRejectsOutOfOrderSystem(t *testing.T) {
+	messages := []map[string]any{
+		{"role": "user", "content": "Hello"},
+		{"role": "system", "content": "Be concise."},
+	}
+
+	_, _, err := LinguaToAnthropicRequest(messages, AnthropicRequestOptions{Strict: true})
+	require.Error(t, err)
+}
+
+func TestAnthropicRequestToLinguaRestoresSystemMessage(t *testing.T) {
+	messages := []map[string]any{
+		{"role": "user", "content": []map[string]any{{"type": "text", "text": "Hello"}}},
+	}
+
+	linguaMsgs, err := AnthropicRequestToLingua("Be concise.", messages)
+	require.NoError(t, err)
+	require.Len(t, linguaMsgs, 2)
+	require.Equal(t, "system", linguaMsgs[0]["role"])
+	require.Equal(t, "Be concise.", linguaMsgs[0]["content"])
+}
+
+func TestAnthropicRequestToLinguaRestoresEachSystemBlockSeparately(t *testing.T) {
+	system := []any{
+		map[string]any{"type": "text", "text": "Be concise."},
+		map[string]any{"type": "text", "text": "Be polite."},
+	}
+	messages := []map[string]any{
+		{"role": "user", "content": []map[string]any{{"type": "text", "text": "Hello"}}},
+	}
+
+	linguaMsgs, err := AnthropicRequestToLingua(system, messages)
+	require.NoError(t, err)
+	require.Len(t, linguaMsgs, 3)
+	require.Equal(t, "system", linguaMsgs[0]["role"])
+	require.Equal(t, "Be concise.", linguaMsgs[0]["content"])
+	require.Equal(t, "system", linguaMsgs[1]["role"])
+	require.Equal(t, "Be polite.", linguaMsgs[1]["content"])
+}
+
+func TestAnthropicRequestRoundTripIsLossless(t *testing.T) {
+	original := []map[string]any{
+		{"role": "system", "content": "Be concise."},
+		{"role": "user", "content": "Hello"},
+	}
+
+	system, anthropicMsgs, err := LinguaToAnthropicRequest(original, AnthropicRequestOptions{})
+	require.NoError(t, err)
+
+	restored, err := AnthropicRequestToLingua(system, anthropicMsgs)
+	require.NoError(t, err)
+	require.Len(t, restored, 2)
+	assertJSONEqual(t, original[0], restored[0], "system message should round-trip")
+}
@@ -0,0 +1,31 @@
+package lingua
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// This file implements assertJSONEqual, used throughout this package's tests to compare
+// a map[string]any value against a typed Message (or vice versa) by their serialized
+// form, since the two representations are expected to be JSON-equivalent but are not
+// comparable with require.Equal directly.
+
+// assertJSONEqual marshals want and got to JSON and asserts the results decode to equal
+// values, so differences in concrete Go type that serialize identically (e.g.
+// map[string]any vs Message) don't fail the comparison.
+func assertJSONEqual(t *testing.T, want, got any, msgAndArgs ...any) {
+	t.Helper()
+
+	wantJSON, err := json.Marshal(want)
+	require.NoError(t, err)
+	gotJSON, err := json.Marshal(got)
+	require.NoError(t, err)
+
+	var wantNormalized, gotNormalized any
+	require.NoError(t, json.Unmarshal(wantJSON, &wantNormalized))
+	require.NoError(t, json.Unmarshal(gotJSON, &gotNormalized))
+
+	require.Equal(t, wantNormalized, gotNormalized, msgAndArgs...)
+}
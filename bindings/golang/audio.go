@@ -0,0 +1,23 @@
+package lingua
+
+// ContentBlockAudio identifies an audio content block:
+// `{"type": "audio", "audio", "media_type", "transcript", "provider_options"}`.
+//
+// It is analogous to the existing image block exercised in TestComplexMessageContent,
+// and flows through the same Chat Completions/Anthropic/Gemini conversions: Chat
+// Completions `input_audio` parts and assistant `audio` responses, and Gemini
+// `inlineData` parts with an audio mimeType.
+const ContentBlockAudio = "audio"
+
+// NewAudioBlock builds a canonical Lingua audio content block. audio is a URL or
+// base64-encoded payload, mediaType is one of "audio/wav", "audio/mp3", or "audio/url",
+// and transcript is the optional known transcription of the audio.
+func NewAudioBlock(audio, mediaType, transcript string, providerOptions any) map[string]any {
+	return map[string]any{
+		"type":             ContentBlockAudio,
+		"audio":            audio,
+		"media_type":       mediaType,
+		"transcript":       transcript,
+		"provider_options": providerOptions,
+	}
+}
@@ -0,0 +1,77 @@
+//go:build cgo
+
+package lingua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatCompletionsAudioConversion(t *testing.T) {
+	chatMsgs := []map[string]any{
+		{
+			"role": "user",
+			"content": []map[string]any{
+				{"type": "text", "text": "What did they say?"},
+				{
+					"type": "input_audio",
+					"input_audio": map[string]any{
+						"data":   "aGVsbG8=",
+						"format": "wav",
+					},
+				},
+			},
+		},
+	}
+
+	linguaMsgs, err := ChatCompletionsMessagesToLingua(chatMsgs)
+	require.NoError(t, err)
+	require.Len(t, linguaMsgs, 1)
+
+	content, ok := linguaMsgs[0]["content"].([]any)
+	require.True(t, ok)
+	require.Len(t, content, 2)
+
+	backToChat, err := LinguaToChatCompletionsMessages(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, backToChat, 1)
+}
+
+func TestGeminiAudioInlineDataConversion(t *testing.T) {
+	geminiMsgs := []map[string]any{
+		{
+			"role": "user",
+			"parts": []map[string]any{
+				{
+					"inlineData": map[string]any{
+						"mimeType": "audio/mp3",
+						"data":     "aGVsbG8=",
+					},
+				},
+			},
+		},
+	}
+
+	linguaMsgs, err := GeminiMessagesToLingua(geminiMsgs)
+	require.NoError(t, err)
+	require.Len(t, linguaMsgs, 1)
+}
+
+func TestChatCompletionsTranscriptionConversion(t *testing.T) {
+	transcription := map[string]any{
+		"text": "Hello, world.",
+		"segments": []map[string]any{
+			{"id": 0, "start": 0.0, "end": 1.2, "text": "Hello, world."},
+		},
+		"response_format": "verbose_json",
+	}
+
+	linguaMsg, err := ChatCompletionsTranscriptionToLingua(transcription)
+	require.NoError(t, err)
+	require.NotNil(t, linguaMsg)
+
+	backToChat, err := LinguaToChatCompletionsTranscription(linguaMsg)
+	require.NoError(t, err)
+	require.NotNil(t, backToChat)
+}
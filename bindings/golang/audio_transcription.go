@@ -0,0 +1,67 @@
+//go:build cgo
+
+package lingua
+
+import "encoding/json"
+
+// ChatCompletionsTranscriptionToLingua converts a Whisper-style transcription/translation
+// request+response pair (multipart file, `response_format`, `segments[]`) into a Lingua
+// message so audio-only spans can be imported alongside chat spans by
+// ImportMessagesFromSpans.
+func ChatCompletionsTranscriptionToLingua(transcription any) (map[string]any, error) {
+	jsonBytes, err := json.Marshal(transcription)
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  "failed to marshal input: " + err.Error(),
+			Provider: "Chat Completions",
+		}
+	}
+
+	resultJSON, err := callRustFunction(fnChatCompletionsTranscriptionToLingua, string(jsonBytes))
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  err.Error(),
+			Provider: "Chat Completions",
+		}
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal([]byte(resultJSON), &result); err != nil {
+		return nil, &ConversionError{
+			Message:  "failed to unmarshal result: " + err.Error(),
+			Provider: "Chat Completions",
+		}
+	}
+
+	return result, nil
+}
+
+// LinguaToChatCompletionsTranscription converts a Lingua audio message back into the
+// Whisper-style transcription response shape.
+func LinguaToChatCompletionsTranscription(message any) (map[string]any, error) {
+	jsonBytes, err := json.Marshal(message)
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  "failed to marshal input: " + err.Error(),
+			Provider: "Chat Completions",
+		}
+	}
+
+	resultJSON, err := callRustFunction(fnLinguaToChatCompletionsTranscription, string(jsonBytes))
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  err.Error(),
+			Provider: "Chat Completions",
+		}
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal([]byte(resultJSON), &result); err != nil {
+		return nil, &ConversionError{
+			Message:  "failed to unmarshal result: " + err.Error(),
+			Provider: "Chat Completions",
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,35 @@
+package lingua
+
+import "encoding/json"
+
+// ConvertDirection names one of the six Lingua conversion directions, for use with
+// ConvertBatch/ConvertBytes where the direction travels as data instead of a function
+// call.
+type ConvertDirection string
+
+const (
+	DirectionChatCompletionsToLingua ConvertDirection = "chat_completions_to_lingua"
+	DirectionLinguaToChatCompletions ConvertDirection = "lingua_to_chat_completions"
+	DirectionResponsesToLingua       ConvertDirection = "responses_to_lingua"
+	DirectionLinguaToResponses       ConvertDirection = "lingua_to_responses"
+	DirectionAnthropicToLingua       ConvertDirection = "anthropic_to_lingua"
+	DirectionLinguaToAnthropic       ConvertDirection = "lingua_to_anthropic"
+)
+
+// ConvertOp is one unit of work for ConvertBatch: a direction plus its already
+// JSON-serialized payload, so callers that already hold request bytes (e.g. a proxy
+// that just read them off the wire) don't pay for an unmarshal/marshal round-trip
+// before the batch call.
+type ConvertOp struct {
+	Direction ConvertDirection
+	Payload   []byte
+}
+
+// ConvertResult is one outcome of ConvertBatch, holding either the raw result JSON or
+// an error message, mirroring the Rust side's `{ok, result|error}` shape so a failure
+// in one op doesn't fail the whole batch.
+type ConvertResult struct {
+	OK     bool
+	Result json.RawMessage
+	Error  string
+}
@@ -0,0 +1,51 @@
+package lingua
+
+import (
+	"bytes"
+	"sync"
+)
+
+// scratchBufferPool holds reusable buffers for ConvertBytes so repeated single-op
+// conversions in a hot path (e.g. a provider-translating proxy) don't allocate a new
+// buffer per request.
+var scratchBufferPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// ConvertBytes runs a single conversion and returns the raw result JSON bytes,
+// skipping the []map[string]any unmarshal, for callers that only need to forward the
+// JSON on (e.g. straight to an HTTP client) rather than inspect it.
+func ConvertBytes(direction ConvertDirection, payload []byte) ([]byte, error) {
+	buf, _ := scratchBufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer scratchBufferPool.Put(buf)
+
+	if err := ConvertBytesInto(buf, direction, payload); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, buf.Len())
+	copy(out, buf.Bytes())
+	return out, nil
+}
+
+// ConvertBytesInto behaves like ConvertBytes but writes the result JSON into dst
+// instead of allocating a new slice, so a caller that already owns a scratch buffer
+// (e.g. one pulled from its own pool per request) can reuse it across conversions.
+func ConvertBytesInto(dst *bytes.Buffer, direction ConvertDirection, payload []byte) error {
+	results, err := ConvertBatch([]ConvertOp{{Direction: direction, Payload: payload}})
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		return &ConversionError{Message: "batch conversion returned no results"}
+	}
+
+	result := results[0]
+	if !result.OK {
+		return &ConversionError{Message: result.Error}
+	}
+
+	dst.Write(result.Result)
+	return nil
+}
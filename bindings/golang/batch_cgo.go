@@ -0,0 +1,59 @@
+//go:build cgo
+
+package lingua
+
+import "encoding/json"
+
+type batchOpWire struct {
+	Op      ConvertDirection `json:"op"`
+	Payload json.RawMessage  `json:"payload"`
+}
+
+type batchResultWire struct {
+	OK     bool            `json:"ok"`
+	Result json.RawMessage `json:"result"`
+	Error  string          `json:"error"`
+}
+
+// ConvertBatch runs many conversions in a single CGo boundary crossing: every op is
+// packed into one JSON array, handed to the Rust `lingua_batch` entry point, and
+// unpacked back into per-op results, so N conversions cost one CString/GoString pair
+// instead of N. A failure in one op surfaces as that op's ConvertResult.Error rather
+// than failing the whole batch.
+func ConvertBatch(ops []ConvertOp) ([]ConvertResult, error) {
+	out := make([]ConvertResult, len(ops))
+	wire := make([]batchOpWire, 0, len(ops))
+	wireIndex := make([]int, 0, len(ops))
+	for i, op := range ops {
+		// json.Marshal rejects an invalid json.RawMessage, which would fail the whole
+		// batch, so an op whose payload is not valid JSON fails on its own here.
+		if !json.Valid(op.Payload) {
+			out[i] = ConvertResult{Error: "invalid JSON payload"}
+			continue
+		}
+		wire = append(wire, batchOpWire{Op: op.Direction, Payload: op.Payload})
+		wireIndex = append(wireIndex, i)
+	}
+
+	jsonBytes, err := json.Marshal(wire)
+	if err != nil {
+		return nil, &ConversionError{Message: "failed to marshal batch: " + err.Error()}
+	}
+
+	resultJSON, err := callRustFunction(fnBatch, string(jsonBytes))
+	if err != nil {
+		return nil, &ConversionError{Message: err.Error()}
+	}
+
+	var results []batchResultWire
+	if err := json.Unmarshal([]byte(resultJSON), &results); err != nil {
+		return nil, &ConversionError{Message: "failed to unmarshal batch result: " + err.Error()}
+	}
+
+	for j, r := range results {
+		if j < len(wireIndex) {
+			out[wireIndex[j]] = ConvertResult{OK: r.OK, Result: r.Result, Error: r.Error}
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,48 @@
+//go:build !cgo
+
+package lingua
+
+import "encoding/json"
+
+var directionConverters = map[ConvertDirection]func(any) ([]map[string]any, error){
+	DirectionChatCompletionsToLingua: ChatCompletionsMessagesToLingua,
+	DirectionLinguaToChatCompletions: LinguaToChatCompletionsMessages,
+	DirectionResponsesToLingua:       ResponsesMessagesToLingua,
+	DirectionLinguaToResponses:       LinguaToResponsesMessages,
+	DirectionAnthropicToLingua:       AnthropicMessagesToLingua,
+	DirectionLinguaToAnthropic:       LinguaToAnthropicMessages,
+}
+
+// ConvertBatch runs each op through the converter its Direction names, sequentially.
+// The pure-Go build has no CGo boundary to amortize, so this exists for API parity
+// with the CGo build's batched FFI path, not for its performance benefit.
+func ConvertBatch(ops []ConvertOp) ([]ConvertResult, error) {
+	out := make([]ConvertResult, len(ops))
+	for i, op := range ops {
+		convert, ok := directionConverters[op.Direction]
+		if !ok {
+			out[i] = ConvertResult{Error: "unknown conversion direction " + string(op.Direction)}
+			continue
+		}
+
+		var payload any
+		if err := json.Unmarshal(op.Payload, &payload); err != nil {
+			out[i] = ConvertResult{Error: err.Error()}
+			continue
+		}
+
+		converted, err := convert(payload)
+		if err != nil {
+			out[i] = ConvertResult{Error: err.Error()}
+			continue
+		}
+
+		resultJSON, err := json.Marshal(converted)
+		if err != nil {
+			out[i] = ConvertResult{Error: err.Error()}
+			continue
+		}
+		out[i] = ConvertResult{OK: true, Result: resultJSON}
+	}
+	return out, nil
+}
@@ -0,0 +1,48 @@
+package lingua
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertBatchRunsEachOpIndependently(t *testing.T) {
+	ops := []ConvertOp{
+		{Direction: DirectionChatCompletionsToLingua, Payload: []byte(`[{"role":"user","content":"Hello"}]`)},
+		{Direction: DirectionAnthropicToLingua, Payload: []byte(`[{"role":"user","content":"Hi"}]`)},
+	}
+
+	results, err := ConvertBatch(ops)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.True(t, results[0].OK)
+	require.True(t, results[1].OK)
+}
+
+func TestConvertBatchReportsPerOpFailureWithoutFailingBatch(t *testing.T) {
+	ops := []ConvertOp{
+		{Direction: DirectionChatCompletionsToLingua, Payload: []byte(`not valid json`)},
+		{Direction: DirectionChatCompletionsToLingua, Payload: []byte(`[{"role":"user","content":"Hello"}]`)},
+	}
+
+	results, err := ConvertBatch(ops)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.False(t, results[0].OK)
+	require.NotEmpty(t, results[0].Error)
+	require.True(t, results[1].OK)
+}
+
+func TestConvertBytesSkipsMapUnmarshal(t *testing.T) {
+	resultJSON, err := ConvertBytes(DirectionChatCompletionsToLingua, []byte(`[{"role":"user","content":"Hello"}]`))
+	require.NoError(t, err)
+	require.Contains(t, string(resultJSON), "Hello")
+}
+
+func TestConvertBytesIntoReusesCallerBuffer(t *testing.T) {
+	var buf bytes.Buffer
+	err := ConvertBytesInto(&buf, DirectionChatCompletionsToLingua, []byte(`[{"role":"user","content":"Hello"}]`))
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), "Hello")
+}
@@ -0,0 +1,95 @@
+//go:build cgo
+
+package lingua
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ============================================================================
+// AWS Bedrock Converse API Conversions
+// ============================================================================
+
+// BedrockConverseMessagesToLingua converts AWS Bedrock Converse API messages to Lingua format.
+//
+// Converse content blocks (`text`, `image`, `document`, `toolUse`, `toolResult`) map onto
+// Lingua's text/image/tool_use/tool_result blocks; `toolUse`/`toolResult` use the same
+// canonical tool-call representation as the Anthropic path so traces can round-trip
+// Chat Completions -> Anthropic -> Bedrock.
+func BedrockConverseMessagesToLingua(messages any) ([]map[string]any, error) {
+	jsonBytes, err := json.Marshal(messages)
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  "failed to marshal input: " + err.Error(),
+			Provider: "Bedrock Converse",
+		}
+	}
+
+	resultJSON, err := callRustFunction(fnBedrockConverseToLingua, string(jsonBytes))
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  err.Error(),
+			Provider: "Bedrock Converse",
+		}
+	}
+
+	var result []map[string]any
+	err = json.Unmarshal([]byte(resultJSON), &result)
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  "failed to unmarshal result: " + err.Error(),
+			Provider: "Bedrock Converse",
+		}
+	}
+
+	return result, nil
+}
+
+// LinguaToBedrockConverseMessages converts Lingua messages to AWS Bedrock Converse API format.
+//
+// A leading `system` Lingua message is not emitted as a `content` entry; callers that need
+// Converse's top-level `system` array should extract it before calling this function.
+func LinguaToBedrockConverseMessages(messages any) ([]map[string]any, error) {
+	jsonBytes, err := json.Marshal(messages)
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  "failed to marshal input: " + err.Error(),
+			Provider: "Bedrock Converse",
+		}
+	}
+
+	resultJSON, err := callRustFunction(fnLinguaToBedrockConverse, string(jsonBytes))
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  err.Error(),
+			Provider: "Bedrock Converse",
+		}
+	}
+
+	var result []map[string]any
+	err = json.Unmarshal([]byte(resultJSON), &result)
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  "failed to unmarshal result: " + err.Error(),
+			Provider: "Bedrock Converse",
+		}
+	}
+
+	return result, nil
+}
+
+// ValidateBedrockConverseRequest validates a JSON string as a Bedrock Converse request.
+func ValidateBedrockConverseRequest(jsonStr string) (map[string]any, error) {
+	resultJSON, err := callRustFunction(fnValidateBedrockConverseRequest, jsonStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]any
+	if unmarshalErr := json.Unmarshal([]byte(resultJSON), &result); unmarshalErr != nil {
+		return nil, errors.New("failed to unmarshal result: " + unmarshalErr.Error())
+	}
+
+	return result, nil
+}
@@ -0,0 +1,56 @@
+//go:build cgo
+
+package lingua
+
+import "fmt"
+
+// BedrockConverseRequestOptions configures how system messages are lifted out of a
+// Lingua message slice when building a Bedrock Converse request.
+type BedrockConverseRequestOptions struct {
+	Strict bool
+}
+
+// LinguaToBedrockConverseRequest converts Lingua messages to Bedrock Converse's shape,
+// lifting any `role:"system"` messages into Converse's top-level `system` array of
+// `{"text": ...}` blocks.
+func LinguaToBedrockConverseRequest(messages []map[string]any, opts BedrockConverseRequestOptions) ([]map[string]any, []map[string]any, error) {
+	systemParts, rest, err := extractSystemMessages(messages, opts.Strict)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bedrockMsgs, err := LinguaToBedrockConverseMessages(rest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	system := make([]map[string]any, 0, len(systemParts))
+	for _, part := range systemParts {
+		system = append(system, map[string]any{"text": part})
+	}
+
+	return system, bedrockMsgs, nil
+}
+
+// BedrockConverseRequestToLingua converts a Bedrock Converse request's top-level
+// `system` blocks and `messages` array back to Lingua messages, restoring each system
+// block as its own leading `role:"system"` message (rather than joining them into one),
+// so a request with N system blocks round-trips losslessly through
+// LinguaToBedrockConverseRequest, which emits one block per system message.
+func BedrockConverseRequestToLingua(system []map[string]any, messages []map[string]any) ([]map[string]any, error) {
+	linguaMsgs, err := BedrockConverseMessagesToLingua(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	systemMsgs := make([]map[string]any, 0, len(system))
+	for _, block := range system {
+		text, ok := block["text"].(string)
+		if !ok {
+			return nil, fmt.Errorf("lingua: bedrock system block missing text field")
+		}
+		systemMsgs = append(systemMsgs, map[string]any{"role": "system", "content": text})
+	}
+
+	return append(systemMsgs, linguaMsgs...), nil
+}
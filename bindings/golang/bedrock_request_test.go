@@ -0,0 +1,26 @@
+//go:build cgo
+
+package lingua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBedrockConverseRequestSystemRoundTrip(t *testing.T) {
+	original := []map[string]any{
+		{"role": "system", "content": "Be concise."},
+		{"role": "user", "content": "Hello"},
+	}
+
+	system, bedrockMsgs, err := LinguaToBedrockConverseRequest(original, BedrockConverseRequestOptions{})
+	require.NoError(t, err)
+	require.Len(t, system, 1)
+	require.Equal(t, "Be concise.", system[0]["text"])
+
+	restored, err := BedrockConverseRequestToLingua(system, bedrockMsgs)
+	require.NoError(t, err)
+	require.Len(t, restored, 2)
+	require.Equal(t, "system", restored[0]["role"])
+}
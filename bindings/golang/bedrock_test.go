@@ -0,0 +1,131 @@
+//go:build cgo
+
+package lingua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBedrockConverseConversion(t *testing.T) {
+	bedrockMsgs := []map[string]any{
+		{
+			"role": "user",
+			"content": []map[string]any{
+				{"text": "Hello"},
+			},
+		},
+		{
+			"role": "assistant",
+			"content": []map[string]any{
+				{"text": "Hi there!"},
+			},
+		},
+	}
+
+	linguaMsgs, err := BedrockConverseMessagesToLingua(bedrockMsgs)
+	require.NoError(t, err)
+	require.Len(t, linguaMsgs, 2)
+
+	backToBedrock, err := LinguaToBedrockConverseMessages(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, backToBedrock, 2)
+	require.Equal(t, "assistant", backToBedrock[1]["role"])
+}
+
+func TestBedrockConverseImageConversion(t *testing.T) {
+	bedrockMsgs := []map[string]any{
+		{
+			"role": "user",
+			"content": []map[string]any{
+				{"text": "What's in this image?"},
+				{
+					"image": map[string]any{
+						"format": "png",
+						"source": map[string]any{"bytes": "aGVsbG8="},
+					},
+				},
+			},
+		},
+	}
+
+	linguaMsgs, err := BedrockConverseMessagesToLingua(bedrockMsgs)
+	require.NoError(t, err)
+	require.Len(t, linguaMsgs, 1)
+
+	content, ok := linguaMsgs[0]["content"].([]any)
+	require.True(t, ok, "content should be an array")
+	require.Len(t, content, 2)
+}
+
+func TestBedrockConverseToolUseConversion(t *testing.T) {
+	bedrockMsgs := []map[string]any{
+		{
+			"role": "assistant",
+			"content": []map[string]any{
+				{
+					"toolUse": map[string]any{
+						"toolUseId": "tool_1",
+						"name":      "get_weather",
+						"input":     map[string]any{"location": "SF"},
+					},
+				},
+			},
+		},
+		{
+			"role": "user",
+			"content": []map[string]any{
+				{
+					"toolResult": map[string]any{
+						"toolUseId": "tool_1",
+						"content":   []map[string]any{{"text": "60F"}},
+						"status":    "success",
+					},
+				},
+			},
+		},
+	}
+
+	linguaMsgs, err := BedrockConverseMessagesToLingua(bedrockMsgs)
+	require.NoError(t, err)
+	require.Len(t, linguaMsgs, 2)
+
+	backToBedrock, err := LinguaToBedrockConverseMessages(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, backToBedrock, 2)
+}
+
+func TestCrossProviderConversionChatCompletionsToBedrockConverse(t *testing.T) {
+	chatMsgs := []map[string]any{
+		{"role": "user", "content": "What is the weather?"},
+		{"role": "assistant", "content": "I don't have access to real-time weather data."},
+	}
+
+	linguaMsgs, err := ChatCompletionsMessagesToLingua(chatMsgs)
+	require.NoError(t, err)
+
+	bedrockMsgs, err := LinguaToBedrockConverseMessages(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, bedrockMsgs, 2)
+	require.Equal(t, "assistant", bedrockMsgs[1]["role"])
+}
+
+func TestCrossProviderConversionAnthropicToBedrockConverse(t *testing.T) {
+	anthropicMsgs := []map[string]any{
+		{
+			"role": "user",
+			"content": []map[string]any{
+				{"type": "text", "text": "Hello"},
+			},
+		},
+	}
+
+	linguaMsgs, err := AnthropicMessagesToLingua(anthropicMsgs)
+	require.NoError(t, err)
+
+	bedrockMsgs, err := LinguaToBedrockConverseMessages(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, bedrockMsgs, 1)
+	require.Equal(t, "user", bedrockMsgs[0]["role"])
+}
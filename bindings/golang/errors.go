@@ -0,0 +1,14 @@
+package lingua
+
+// ConversionError represents an error during format conversion.
+type ConversionError struct {
+	Message  string
+	Provider string
+}
+
+func (e *ConversionError) Error() string {
+	if e.Provider != "" {
+		return e.Provider + ": " + e.Message
+	}
+	return e.Message
+}
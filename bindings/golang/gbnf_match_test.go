@@ -0,0 +1,344 @@
+package lingua
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This file implements just enough of a GBNF interpreter to let grammar_test.go assert
+// that a compiled grammar actually accepts/rejects specific input strings, rather than
+// only checking that expected substrings appear in the generated text.
+
+type gbnfNode interface {
+	// match returns every position in input reachable by matching this node starting
+	// at pos (a node may match in more than one way, e.g. across an optional group).
+	match(g *gbnfGrammar, input string, pos int) []int
+}
+
+type gbnfGrammar struct {
+	rules  map[string]string
+	parsed map[string]gbnfNode
+}
+
+func parseGBNF(text string) *gbnfGrammar {
+	g := &gbnfGrammar{rules: map[string]string{}, parsed: map[string]gbnfNode{}}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		name, body, ok := strings.Cut(line, "::=")
+		if !ok {
+			continue
+		}
+		g.rules[strings.TrimSpace(name)] = strings.TrimSpace(body)
+	}
+	return g
+}
+
+// accepts reports whether input is fully matched by rootRule.
+func (g *gbnfGrammar) accepts(rootRule, input string) bool {
+	for _, end := range g.ruleNode(rootRule).match(g, input, 0) {
+		if end == len(input) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *gbnfGrammar) ruleNode(name string) gbnfNode {
+	if node, ok := g.parsed[name]; ok {
+		return node
+	}
+	body, ok := g.rules[name]
+	if !ok {
+		panic(fmt.Sprintf("gbnf: undefined rule %q", name))
+	}
+	node := &gbnfRuleRef{name: name}
+	g.parsed[name] = node // break cycles before parsing the body
+	p := &gbnfParser{tokens: gbnfTokenize(body)}
+	g.parsed[name] = p.parseAlt()
+	return g.parsed[name]
+}
+
+// gbnfRuleRef is a placeholder installed while a rule's body is still being parsed, so
+// a rule that (indirectly) refers to itself doesn't recurse into ruleNode forever.
+type gbnfRuleRef struct{ name string }
+
+func (r *gbnfRuleRef) match(g *gbnfGrammar, input string, pos int) []int {
+	return g.parsed[r.name].match(g, input, pos)
+}
+
+// ---- tokenizer ----
+
+type gbnfToken struct {
+	kind string // "str", "class", "dot", "ident", "(", ")", "?", "*", "|"
+	text string
+}
+
+func gbnfTokenize(body string) []gbnfToken {
+	var tokens []gbnfToken
+	i := 0
+	for i < len(body) {
+		c := body[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '"':
+			j := i + 1
+			for j < len(body) && body[j] != '"' {
+				if body[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			tokens = append(tokens, gbnfToken{"str", body[i+1 : j]})
+			i = j + 1
+		case c == '[':
+			j := i + 1
+			for j < len(body) && body[j] != ']' {
+				if body[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			tokens = append(tokens, gbnfToken{"class", body[i+1 : j]})
+			i = j + 1
+		case c == '.':
+			tokens = append(tokens, gbnfToken{"dot", "."})
+			i++
+		case c == '(' || c == ')' || c == '?' || c == '*' || c == '|':
+			tokens = append(tokens, gbnfToken{string(c), string(c)})
+			i++
+		default:
+			j := i
+			for j < len(body) && !strings.ContainsRune(" \t\"[.()?*|", rune(body[j])) {
+				j++
+			}
+			tokens = append(tokens, gbnfToken{"ident", body[i:j]})
+			i = j
+		}
+	}
+	return tokens
+}
+
+// ---- parser: altExpr := seqExpr ('|' seqExpr)* ; seqExpr := postfix* ----
+
+type gbnfParser struct {
+	tokens []gbnfToken
+	pos    int
+}
+
+func (p *gbnfParser) peek() *gbnfToken {
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	return &p.tokens[p.pos]
+}
+
+func (p *gbnfParser) parseAlt() gbnfNode {
+	alts := []gbnfNode{p.parseSeq()}
+	for p.peek() != nil && p.peek().kind == "|" {
+		p.pos++
+		alts = append(alts, p.parseSeq())
+	}
+	if len(alts) == 1 {
+		return alts[0]
+	}
+	return &gbnfAlt{alts: alts}
+}
+
+func (p *gbnfParser) parseSeq() gbnfNode {
+	var nodes []gbnfNode
+	for p.peek() != nil && p.peek().kind != "|" && p.peek().kind != ")" {
+		nodes = append(nodes, p.parsePostfix())
+	}
+	return &gbnfSeq{nodes: nodes}
+}
+
+func (p *gbnfParser) parsePostfix() gbnfNode {
+	atom := p.parseAtom()
+	for p.peek() != nil && (p.peek().kind == "?" || p.peek().kind == "*") {
+		op := p.peek().kind
+		p.pos++
+		if op == "?" {
+			atom = &gbnfAlt{alts: []gbnfNode{atom, &gbnfSeq{}}}
+		} else {
+			atom = &gbnfStar{inner: atom}
+		}
+	}
+	return atom
+}
+
+func (p *gbnfParser) parseAtom() gbnfNode {
+	tok := p.peek()
+	switch tok.kind {
+	case "str":
+		p.pos++
+		return &gbnfLiteral{text: gbnfUnescape(tok.text)}
+	case "class":
+		p.pos++
+		return gbnfParseClass(tok.text)
+	case "dot":
+		p.pos++
+		return &gbnfDot{}
+	case "ident":
+		p.pos++
+		return &gbnfIdent{name: tok.text}
+	case "(":
+		p.pos++
+		inner := p.parseAlt()
+		if p.peek() != nil && p.peek().kind == ")" {
+			p.pos++
+		}
+		return inner
+	default:
+		panic(fmt.Sprintf("gbnf: unexpected token %q", tok.kind))
+	}
+}
+
+func gbnfUnescape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			switch s[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			default:
+				b.WriteByte(s[i])
+			}
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// ---- AST nodes ----
+
+type gbnfLiteral struct{ text string }
+
+func (n *gbnfLiteral) match(g *gbnfGrammar, input string, pos int) []int {
+	if strings.HasPrefix(input[pos:], n.text) {
+		return []int{pos + len(n.text)}
+	}
+	return nil
+}
+
+type gbnfDot struct{}
+
+func (n *gbnfDot) match(g *gbnfGrammar, input string, pos int) []int {
+	if pos < len(input) {
+		return []int{pos + 1}
+	}
+	return nil
+}
+
+type gbnfCharClass struct {
+	negate bool
+	chars  map[byte]bool
+}
+
+func gbnfParseClass(spec string) *gbnfCharClass {
+	cc := &gbnfCharClass{chars: map[byte]bool{}}
+	i := 0
+	if strings.HasPrefix(spec, "^") {
+		cc.negate = true
+		i = 1
+	}
+	unescaped := gbnfUnescape(spec[i:])
+	for j := 0; j < len(unescaped); j++ {
+		if j+2 < len(unescaped) && unescaped[j+1] == '-' {
+			for c := unescaped[j]; c <= unescaped[j+2]; c++ {
+				cc.chars[c] = true
+			}
+			j += 2
+			continue
+		}
+		cc.chars[unescaped[j]] = true
+	}
+	return cc
+}
+
+func (n *gbnfCharClass) match(g *gbnfGrammar, input string, pos int) []int {
+	if pos >= len(input) {
+		return nil
+	}
+	in := n.chars[input[pos]]
+	if in == n.negate {
+		return nil
+	}
+	return []int{pos + 1}
+}
+
+type gbnfIdent struct{ name string }
+
+func (n *gbnfIdent) match(g *gbnfGrammar, input string, pos int) []int {
+	return g.ruleNode(n.name).match(g, input, pos)
+}
+
+type gbnfSeq struct{ nodes []gbnfNode }
+
+func (n *gbnfSeq) match(g *gbnfGrammar, input string, pos int) []int {
+	positions := []int{pos}
+	for _, node := range n.nodes {
+		var next []int
+		seen := map[int]bool{}
+		for _, p := range positions {
+			for _, np := range node.match(g, input, p) {
+				if !seen[np] {
+					seen[np] = true
+					next = append(next, np)
+				}
+			}
+		}
+		positions = next
+		if len(positions) == 0 {
+			return nil
+		}
+	}
+	return positions
+}
+
+type gbnfAlt struct{ alts []gbnfNode }
+
+func (n *gbnfAlt) match(g *gbnfGrammar, input string, pos int) []int {
+	seen := map[int]bool{}
+	var out []int
+	for _, alt := range n.alts {
+		for _, p := range alt.match(g, input, pos) {
+			if !seen[p] {
+				seen[p] = true
+				out = append(out, p)
+			}
+		}
+	}
+	return out
+}
+
+type gbnfStar struct{ inner gbnfNode }
+
+func (n *gbnfStar) match(g *gbnfGrammar, input string, pos int) []int {
+	seen := map[int]bool{pos: true}
+	frontier := []int{pos}
+	for len(frontier) > 0 {
+		var next []int
+		for _, p := range frontier {
+			for _, np := range n.inner.match(g, input, p) {
+				if np != p && !seen[np] {
+					seen[np] = true
+					next = append(next, np)
+				}
+			}
+		}
+		frontier = next
+	}
+	out := make([]int, 0, len(seen))
+	for p := range seen {
+		out = append(out, p)
+	}
+	return out
+}
@@ -0,0 +1,96 @@
+//go:build cgo
+
+package lingua
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ============================================================================
+// Gemini / Google GenAI Conversions
+// ============================================================================
+
+// GeminiMessagesToLingua converts Gemini/Google GenAI `contents` messages to Lingua format.
+//
+// Gemini uses `role: "user"|"model"` and a `parts` array instead of a flat `content`
+// string; `model` is mapped to Lingua's `assistant` role. `inlineData`/`fileData` parts
+// become Lingua image/media blocks, and `functionCall`/`functionResponse` parts become
+// Lingua tool_use/tool_result blocks.
+func GeminiMessagesToLingua(messages any) ([]map[string]any, error) {
+	jsonBytes, err := json.Marshal(messages)
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  "failed to marshal input: " + err.Error(),
+			Provider: "Gemini",
+		}
+	}
+
+	resultJSON, err := callRustFunction(fnGeminiToLingua, string(jsonBytes))
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  err.Error(),
+			Provider: "Gemini",
+		}
+	}
+
+	var result []map[string]any
+	err = json.Unmarshal([]byte(resultJSON), &result)
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  "failed to unmarshal result: " + err.Error(),
+			Provider: "Gemini",
+		}
+	}
+
+	return result, nil
+}
+
+// LinguaToGeminiMessages converts Lingua messages to Gemini/Google GenAI `contents` format.
+//
+// Lingua `assistant` messages are mapped back to Gemini's `model` role. A leading
+// `system` message is not emitted as a content entry; callers that need Gemini's
+// top-level `systemInstruction` field should extract it before calling this function.
+func LinguaToGeminiMessages(messages any) ([]map[string]any, error) {
+	jsonBytes, err := json.Marshal(messages)
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  "failed to marshal input: " + err.Error(),
+			Provider: "Gemini",
+		}
+	}
+
+	resultJSON, err := callRustFunction(fnLinguaToGemini, string(jsonBytes))
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  err.Error(),
+			Provider: "Gemini",
+		}
+	}
+
+	var result []map[string]any
+	err = json.Unmarshal([]byte(resultJSON), &result)
+	if err != nil {
+		return nil, &ConversionError{
+			Message:  "failed to unmarshal result: " + err.Error(),
+			Provider: "Gemini",
+		}
+	}
+
+	return result, nil
+}
+
+// ValidateGeminiRequest validates a JSON string as a Gemini generateContent request.
+func ValidateGeminiRequest(jsonStr string) (map[string]any, error) {
+	resultJSON, err := callRustFunction(fnValidateGeminiRequest, jsonStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]any
+	if unmarshalErr := json.Unmarshal([]byte(resultJSON), &result); unmarshalErr != nil {
+		return nil, errors.New("failed to unmarshal result: " + unmarshalErr.Error())
+	}
+
+	return result, nil
+}
@@ -0,0 +1,132 @@
+//go:build cgo
+
+package lingua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeminiConversion(t *testing.T) {
+	geminiMsgs := []map[string]any{
+		{
+			"role": "user",
+			"parts": []map[string]any{
+				{"text": "Hello"},
+			},
+		},
+		{
+			"role": "model",
+			"parts": []map[string]any{
+				{"text": "Hi there!"},
+			},
+		},
+	}
+
+	linguaMsgs, err := GeminiMessagesToLingua(geminiMsgs)
+	require.NoError(t, err)
+	require.Len(t, linguaMsgs, 2)
+	require.Equal(t, "user", linguaMsgs[0]["role"])
+	require.Equal(t, "assistant", linguaMsgs[1]["role"])
+
+	backToGemini, err := LinguaToGeminiMessages(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, backToGemini, 2)
+	require.Equal(t, "model", backToGemini[1]["role"])
+}
+
+func TestGeminiInlineDataConversion(t *testing.T) {
+	geminiMsgs := []map[string]any{
+		{
+			"role": "user",
+			"parts": []map[string]any{
+				{"text": "What's in this image?"},
+				{
+					"inlineData": map[string]any{
+						"mimeType": "image/png",
+						"data":     "aGVsbG8=",
+					},
+				},
+			},
+		},
+	}
+
+	linguaMsgs, err := GeminiMessagesToLingua(geminiMsgs)
+	require.NoError(t, err)
+	require.Len(t, linguaMsgs, 1)
+
+	content, ok := linguaMsgs[0]["content"].([]any)
+	require.True(t, ok, "content should be an array")
+	require.Len(t, content, 2)
+}
+
+func TestGeminiFunctionCallConversion(t *testing.T) {
+	geminiMsgs := []map[string]any{
+		{
+			"role": "model",
+			"parts": []map[string]any{
+				{
+					"functionCall": map[string]any{
+						"name": "get_weather",
+						"args": map[string]any{"location": "SF"},
+					},
+				},
+			},
+		},
+		{
+			"role": "user",
+			"parts": []map[string]any{
+				{
+					"functionResponse": map[string]any{
+						"name":     "get_weather",
+						"response": map[string]any{"temperature": 60},
+					},
+				},
+			},
+		},
+	}
+
+	linguaMsgs, err := GeminiMessagesToLingua(geminiMsgs)
+	require.NoError(t, err)
+	require.Len(t, linguaMsgs, 2)
+
+	backToGemini, err := LinguaToGeminiMessages(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, backToGemini, 2)
+}
+
+func TestCrossProviderConversionChatCompletionsToGemini(t *testing.T) {
+	chatMsgs := []map[string]any{
+		{"role": "user", "content": "What is the weather?"},
+		{"role": "assistant", "content": "I don't have access to real-time weather data."},
+	}
+
+	linguaMsgs, err := ChatCompletionsMessagesToLingua(chatMsgs)
+	require.NoError(t, err)
+
+	geminiMsgs, err := LinguaToGeminiMessages(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, geminiMsgs, 2)
+	require.Equal(t, "user", geminiMsgs[0]["role"])
+	require.Equal(t, "model", geminiMsgs[1]["role"])
+}
+
+func TestCrossProviderConversionAnthropicToGemini(t *testing.T) {
+	anthropicMsgs := []map[string]any{
+		{
+			"role": "user",
+			"content": []map[string]any{
+				{"type": "text", "text": "Hello"},
+			},
+		},
+	}
+
+	linguaMsgs, err := AnthropicMessagesToLingua(anthropicMsgs)
+	require.NoError(t, err)
+
+	geminiMsgs, err := LinguaToGeminiMessages(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, geminiMsgs, 1)
+	require.Equal(t, "user", geminiMsgs[0]["role"])
+}
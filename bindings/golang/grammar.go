@@ -0,0 +1,255 @@
+package lingua
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Tool describes a function/tool definition to constrain generation to, for use with
+// CompileToolGrammar. Parameters is the JSON Schema of the tool's arguments object.
+type Tool struct {
+	Name        string
+	Description string
+	Parameters  map[string]any
+}
+
+// GrammarMode selects the shape CompileToolGrammar constrains output to.
+type GrammarMode int
+
+const (
+	// SingleCall constrains output to exactly one `{"function":"<name>","arguments":{...}}`
+	// call for a single, predetermined tool.
+	SingleCall GrammarMode = iota
+	// Choice constrains output to a call to any one of the provided tools.
+	Choice
+)
+
+// jsonPreamble defines the shared GBNF rules for JSON whitespace, primitives, and an
+// any-shape "value" (with its "object"/"array"/"member" helpers) that every compiled
+// grammar depends on. "value" backs the no-/unknown-type schema fallback in
+// compileSchemaBody, so a schema node that doesn't constrain its shape still compiles to
+// a grammar with no undefined-rule references.
+const jsonPreamble = `ws ::= [ \t\n]*
+string ::= "\"" ( [^"\\] | "\\" . )* "\""
+number ::= "-"? ("0" | [1-9] [0-9]*) ("." [0-9]+)? ([eE] [-+]? [0-9]+)?
+boolean ::= "true" | "false"
+null ::= "null"
+value ::= object | array | string | number | boolean | null
+object ::= "{" ws ( member ( ws "," ws member )* )? ws "}"
+member ::= string ws ":" ws value
+array ::= "[" ws ( value ( ws "," ws value )* )? ws "]"
+`
+
+// CompileToolGrammar compiles tools into a GBNF grammar string usable by llama.cpp /
+// LocalAI-style local backends to constrain generation to a valid tool-call JSON object.
+func CompileToolGrammar(tools []Tool, mode GrammarMode) (string, error) {
+	if len(tools) == 0 {
+		return "", fmt.Errorf("lingua: CompileToolGrammar requires at least one tool")
+	}
+
+	var b strings.Builder
+	b.WriteString(jsonPreamble)
+
+	callRules := make([]string, 0, len(tools))
+	for i, tool := range tools {
+		ruleName := fmt.Sprintf("call-%d", i)
+		argsRule := fmt.Sprintf("args-%d", i)
+
+		if err := compileSchemaRule(&b, argsRule, tool.Parameters); err != nil {
+			return "", fmt.Errorf("lingua: failed to compile grammar for tool %q: %w", tool.Name, err)
+		}
+
+		fmt.Fprintf(&b, "%s ::= \"{\" ws \"\\\"function\\\":\" ws \"\\\"%s\\\"\" ws \",\" ws \"\\\"arguments\\\":\" ws %s ws \"}\"\n",
+			ruleName, tool.Name, argsRule)
+		callRules = append(callRules, ruleName)
+	}
+
+	switch mode {
+	case SingleCall:
+		if len(tools) != 1 {
+			return "", fmt.Errorf("lingua: SingleCall mode requires exactly one tool, got %d", len(tools))
+		}
+		fmt.Fprintf(&b, "root ::= %s\n", callRules[0])
+	case Choice:
+		fmt.Fprintf(&b, "root ::= %s\n", strings.Join(callRules, " | "))
+	default:
+		return "", fmt.Errorf("lingua: unknown grammar mode %v", mode)
+	}
+
+	return b.String(), nil
+}
+
+// compileSchemaRule walks a JSON Schema node and writes a named GBNF rule (plus any
+// rules it depends on) for it to b.
+func compileSchemaRule(b *strings.Builder, ruleName string, schema map[string]any) error {
+	body, err := compileSchemaBody(b, ruleName, schema)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(b, "%s ::= %s\n", ruleName, body)
+	return nil
+}
+
+// compileSchemaBody returns the GBNF expression for schema, emitting any sub-rules it
+// needs (for object properties, array items, etc.) as side effects on b.
+func compileSchemaBody(b *strings.Builder, ruleName string, schema map[string]any) (string, error) {
+	if ref, ok := schema["$ref"].(string); ok {
+		return "", fmt.Errorf("lingua: unresolved $ref %q (resolve refs before compiling)", ref)
+	}
+
+	if enumValues, ok := schema["enum"].([]any); ok {
+		return compileEnum(enumValues)
+	}
+
+	if oneOf, ok := schema["oneOf"].([]any); ok {
+		return compileOneOf(b, ruleName, oneOf)
+	}
+
+	switch schema["type"] {
+	case "object":
+		return compileObject(b, ruleName, schema)
+	case "array":
+		return compileArray(b, ruleName, schema)
+	case "string":
+		return "string", nil
+	case "number":
+		return "number", nil
+	case "integer":
+		return "number", nil
+	case "boolean":
+		return "boolean", nil
+	case "null":
+		return "null", nil
+	default:
+		// No (or unrecognized) type constraint: accept any JSON value shape we know,
+		// via the shared "value" rule defined in jsonPreamble.
+		return "value", nil
+	}
+}
+
+func compileEnum(values []any) (string, error) {
+	alts := make([]string, 0, len(values))
+	for _, v := range values {
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		alts = append(alts, fmt.Sprintf("%q", string(encoded)))
+	}
+	return "(" + strings.Join(alts, " | ") + ")", nil
+}
+
+func compileOneOf(b *strings.Builder, ruleName string, oneOf []any) (string, error) {
+	alts := make([]string, 0, len(oneOf))
+	for i, sub := range oneOf {
+		subSchema, ok := sub.(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("lingua: oneOf entry %d is not an object schema", i)
+		}
+		subRule := fmt.Sprintf("%s-of-%d", ruleName, i)
+		if err := compileSchemaRule(b, subRule, subSchema); err != nil {
+			return "", err
+		}
+		alts = append(alts, subRule)
+	}
+	return "(" + strings.Join(alts, " | ") + ")", nil
+}
+
+func compileObject(b *strings.Builder, ruleName string, schema map[string]any) (string, error) {
+	properties, _ := schema["properties"].(map[string]any)
+	required := map[string]bool{}
+	if reqList, ok := schema["required"].([]any); ok {
+		for _, r := range reqList {
+			if name, ok := r.(string); ok {
+				required[name] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if len(names) == 0 {
+		return `"{" ws "}"`, nil
+	}
+
+	type objField struct {
+		rule     string
+		required bool
+	}
+	fields := make([]objField, 0, len(names))
+	for _, name := range names {
+		propSchema, ok := properties[name].(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("lingua: property %q schema is not an object", name)
+		}
+		propRule := fmt.Sprintf("%s-%s", ruleName, name)
+		if err := compileSchemaRule(b, propRule, propSchema); err != nil {
+			return "", err
+		}
+		fields = append(fields, objField{
+			rule:     fmt.Sprintf("ws \"\\\"%s\\\":\" ws %s", name, propRule),
+			required: required[name],
+		})
+	}
+
+	// Generate a "first"/"continuation" rule pair per field, built back-to-front: first(i)
+	// is used when no earlier field has been emitted yet (an included field needs no
+	// leading comma), cont(i) is used once something has (an included field must be
+	// comma-separated from it). This keeps the comma bound to whichever field actually
+	// turns out to be present, instead of a flat separator that would strand a dangling
+	// comma whenever an optional field in the middle is skipped.
+	firstName := func(i int) string { return fmt.Sprintf("%s-first-%d", ruleName, i) }
+	contName := func(i int) string { return fmt.Sprintf("%s-cont-%d", ruleName, i) }
+
+	fmt.Fprintf(b, "%s ::= \"\"\n", firstName(len(fields)))
+	fmt.Fprintf(b, "%s ::= \"\"\n", contName(len(fields)))
+	for i := len(fields) - 1; i >= 0; i-- {
+		field := fields[i]
+		if field.required {
+			fmt.Fprintf(b, "%s ::= %s %s\n", firstName(i), field.rule, contName(i+1))
+			fmt.Fprintf(b, "%s ::= \",\" %s %s\n", contName(i), field.rule, contName(i+1))
+		} else {
+			fmt.Fprintf(b, "%s ::= ( %s %s | %s )\n", firstName(i), field.rule, contName(i+1), firstName(i+1))
+			fmt.Fprintf(b, "%s ::= ( \",\" %s %s )?\n", contName(i), field.rule, contName(i+1))
+		}
+	}
+
+	return fmt.Sprintf(`"{" %s ws "}"`, firstName(0)), nil
+}
+
+func compileArray(b *strings.Builder, ruleName string, schema map[string]any) (string, error) {
+	items, ok := schema["items"].(map[string]any)
+	if !ok {
+		return `"[" ws "]"`, nil
+	}
+
+	itemRule := ruleName + "-item"
+	if err := compileSchemaRule(b, itemRule, items); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(`"[" ws ( %s ( ws "," ws %s )* )? ws "]"`, itemRule, itemRule), nil
+}
+
+// ParseConstrainedToolCall parses a model's constrained `{"function":"<name>","arguments":{...}}`
+// output (as produced against a CompileToolGrammar grammar) into a Lingua ToolCallPart.
+func ParseConstrainedToolCall(output string) (ToolCallPart, error) {
+	var parsed struct {
+		Function  string `json:"function"`
+		Arguments any    `json:"arguments"`
+	}
+	if err := json.Unmarshal([]byte(output), &parsed); err != nil {
+		return ToolCallPart{}, fmt.Errorf("lingua: failed to parse constrained tool call: %w", err)
+	}
+	if parsed.Function == "" {
+		return ToolCallPart{}, fmt.Errorf("lingua: constrained tool call output is missing \"function\"")
+	}
+
+	return ToolCallPart{Name: parsed.Function, Input: parsed.Arguments}, nil
+}
@@ -0,0 +1,135 @@
+package lingua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileToolGrammarSingleCall(t *testing.T) {
+	tool := Tool{
+		Name:        "get_weather",
+		Description: "Get the weather for a location",
+		Parameters: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"location": map[string]any{"type": "string"},
+				"unit":     map[string]any{"type": "string", "enum": []any{"celsius", "fahrenheit"}},
+			},
+			"required": []any{"location"},
+		},
+	}
+
+	grammar, err := CompileToolGrammar([]Tool{tool}, SingleCall)
+	require.NoError(t, err)
+	require.Contains(t, grammar, "root ::=")
+	require.Contains(t, grammar, "get_weather")
+}
+
+func TestCompileToolGrammarOptionalPropertyRejectsTrailingComma(t *testing.T) {
+	tool := Tool{
+		Name: "get_weather",
+		Parameters: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"location": map[string]any{"type": "string"},
+				"unit":     map[string]any{"type": "string", "enum": []any{"celsius", "fahrenheit"}},
+			},
+			"required": []any{"location"},
+		},
+	}
+
+	grammar, err := CompileToolGrammar([]Tool{tool}, SingleCall)
+	require.NoError(t, err)
+
+	g := parseGBNF(grammar)
+	call := `{"function":"get_weather","arguments":{"location":"SF"}}`
+	callWithOptional := `{"function":"get_weather","arguments":{"location":"SF","unit":"celsius"}}`
+	trailingComma := `{"function":"get_weather","arguments":{"location":"SF",}}`
+	missingRequired := `{"function":"get_weather","arguments":{"unit":"celsius"}}`
+
+	require.True(t, g.accepts("root", call), "should accept the required-only field")
+	require.True(t, g.accepts("root", callWithOptional), "should accept required + optional fields")
+	require.False(t, g.accepts("root", trailingComma), "should reject a dangling comma when the optional field is omitted")
+	require.False(t, g.accepts("root", missingRequired), "should reject omitting a required field")
+}
+
+func TestCompileToolGrammarChoice(t *testing.T) {
+	tools := []Tool{
+		{Name: "get_weather", Parameters: map[string]any{"type": "object", "properties": map[string]any{}}},
+		{Name: "get_time", Parameters: map[string]any{"type": "object", "properties": map[string]any{}}},
+	}
+
+	grammar, err := CompileToolGrammar(tools, Choice)
+	require.NoError(t, err)
+	require.Contains(t, grammar, "get_weather")
+	require.Contains(t, grammar, "get_time")
+	require.Contains(t, grammar, " | ")
+}
+
+func TestCompileToolGrammarRequiresAtLeastOneTool(t *testing.T) {
+	_, err := CompileToolGrammar(nil, SingleCall)
+	require.Error(t, err)
+}
+
+func TestCompileToolGrammarSingleCallRejectsMultipleTools(t *testing.T) {
+	tools := []Tool{
+		{Name: "a", Parameters: map[string]any{"type": "object", "properties": map[string]any{}}},
+		{Name: "b", Parameters: map[string]any{"type": "object", "properties": map[string]any{}}},
+	}
+	_, err := CompileToolGrammar(tools, SingleCall)
+	require.Error(t, err)
+}
+
+func TestCompileToolGrammarUntypedPropertyAcceptsAnyValue(t *testing.T) {
+	tool := Tool{
+		Name: "note",
+		Parameters: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"metadata": map[string]any{"description": "arbitrary JSON, no declared type"},
+			},
+			"required": []any{"metadata"},
+		},
+	}
+
+	grammar, err := CompileToolGrammar([]Tool{tool}, SingleCall)
+	require.NoError(t, err)
+
+	g := parseGBNF(grammar)
+	withString := `{"function":"note","arguments":{"metadata":"hi"}}`
+	withObject := `{"function":"note","arguments":{"metadata":{"a":1,"b":[true,null]}}}`
+
+	require.True(t, g.accepts("root", withString), "untyped property should accept a string value")
+	require.True(t, g.accepts("root", withObject), "untyped property should accept a nested object/array value")
+}
+
+func TestParseConstrainedToolCall(t *testing.T) {
+	output := `{"function":"get_weather","arguments":{"location":"SF"}}`
+
+	toolCall, err := ParseConstrainedToolCall(output)
+	require.NoError(t, err)
+	require.Equal(t, "get_weather", toolCall.Name)
+
+	args, ok := toolCall.Input.(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "SF", args["location"])
+}
+
+func TestParseConstrainedToolCallFeedsBackIntoAnthropicConversion(t *testing.T) {
+	output := `{"function":"get_weather","arguments":{"location":"SF"}}`
+	toolCall, err := ParseConstrainedToolCall(output)
+	require.NoError(t, err)
+
+	toolCall.ID = "toolu_1"
+	anthropicMsgs := []map[string]any{
+		{
+			"role":    "assistant",
+			"content": []map[string]any{NewToolUseBlock(toolCall.ID, toolCall.Name, toolCall.Input)},
+		},
+	}
+
+	linguaMsgs, err := AnthropicMessagesToLingua(anthropicMsgs)
+	require.NoError(t, err)
+	require.Len(t, linguaMsgs, 1)
+}
@@ -1,3 +1,5 @@
+//go:build cgo
+
 // Package lingua provides Go bindings for the Lingua universal message format library.
 //
 // Lingua is a universal message format that compiles to provider-specific formats
@@ -5,7 +7,8 @@
 // LLM providers (OpenAI, Anthropic, etc.) through compile-time translation.
 //
 // This package wraps the Rust implementation of Lingua using CGo and provides
-// idiomatic Go functions for message conversion, validation, and processing.
+// idiomatic Go functions for message conversion, validation, and processing. See
+// lingua_purego.go for the pure-Go fallback used on builds without CGo.
 package lingua
 
 /*
@@ -20,13 +23,21 @@ extern char* lingua_to_responses(const char* json, char** error_out);
 extern char* lingua_anthropic_to_lingua(const char* json, char** error_out);
 extern char* lingua_to_anthropic(const char* json, char** error_out);
 extern char* lingua_deduplicate_messages(const char* json, char** error_out);
-extern char* lingua_import_messages_from_spans(const char* json, char** error_out);
 extern char* lingua_validate_chat_completions_request(const char* json, char** error_out);
 extern char* lingua_validate_chat_completions_response(const char* json, char** error_out);
 extern char* lingua_validate_responses_request(const char* json, char** error_out);
 extern char* lingua_validate_responses_response(const char* json, char** error_out);
 extern char* lingua_validate_anthropic_request(const char* json, char** error_out);
 extern char* lingua_validate_anthropic_response(const char* json, char** error_out);
+extern char* lingua_gemini_to_lingua(const char* json, char** error_out);
+extern char* lingua_to_gemini(const char* json, char** error_out);
+extern char* lingua_validate_gemini_request(const char* json, char** error_out);
+extern char* lingua_bedrock_converse_to_lingua(const char* json, char** error_out);
+extern char* lingua_to_bedrock_converse(const char* json, char** error_out);
+extern char* lingua_validate_bedrock_converse_request(const char* json, char** error_out);
+extern char* lingua_chat_completions_transcription_to_lingua(const char* json, char** error_out);
+extern char* lingua_to_chat_completions_transcription(const char* json, char** error_out);
+extern char* lingua_batch(const char* json, char** error_out);
 extern void lingua_free_string(char* s);
 */
 import "C"
@@ -36,19 +47,6 @@ import (
 	"unsafe"
 )
 
-// ConversionError represents an error during format conversion.
-type ConversionError struct {
-	Message  string
-	Provider string
-}
-
-func (e *ConversionError) Error() string {
-	if e.Provider != "" {
-		return e.Provider + ": " + e.Message
-	}
-	return e.Message
-}
-
 // rustFunctionID identifies which Rust FFI function to call.
 type rustFunctionID int
 
@@ -60,13 +58,21 @@ const (
 	fnAnthropicToLingua
 	fnLinguaToAnthropic
 	fnDeduplicateMessages
-	fnImportMessagesFromSpans
 	fnValidateChatCompletionsRequest
 	fnValidateChatCompletionsResponse
 	fnValidateResponsesRequest
 	fnValidateResponsesResponse
 	fnValidateAnthropicRequest
 	fnValidateAnthropicResponse
+	fnGeminiToLingua
+	fnLinguaToGemini
+	fnValidateGeminiRequest
+	fnBedrockConverseToLingua
+	fnLinguaToBedrockConverse
+	fnValidateBedrockConverseRequest
+	fnChatCompletionsTranscriptionToLingua
+	fnLinguaToChatCompletionsTranscription
+	fnBatch
 )
 
 // callRustFunction is a helper to call Rust FFI functions and handle errors.
@@ -94,8 +100,6 @@ func callRustFunction(fnID rustFunctionID, input string) (string, error) {
 		cResult = C.lingua_to_anthropic(cInput, &cError)
 	case fnDeduplicateMessages:
 		cResult = C.lingua_deduplicate_messages(cInput, &cError)
-	case fnImportMessagesFromSpans:
-		cResult = C.lingua_import_messages_from_spans(cInput, &cError)
 	case fnValidateChatCompletionsRequest:
 		cResult = C.lingua_validate_chat_completions_request(cInput, &cError)
 	case fnValidateChatCompletionsResponse:
@@ -108,6 +112,24 @@ func callRustFunction(fnID rustFunctionID, input string) (string, error) {
 		cResult = C.lingua_validate_anthropic_request(cInput, &cError)
 	case fnValidateAnthropicResponse:
 		cResult = C.lingua_validate_anthropic_response(cInput, &cError)
+	case fnGeminiToLingua:
+		cResult = C.lingua_gemini_to_lingua(cInput, &cError)
+	case fnLinguaToGemini:
+		cResult = C.lingua_to_gemini(cInput, &cError)
+	case fnValidateGeminiRequest:
+		cResult = C.lingua_validate_gemini_request(cInput, &cError)
+	case fnBedrockConverseToLingua:
+		cResult = C.lingua_bedrock_converse_to_lingua(cInput, &cError)
+	case fnLinguaToBedrockConverse:
+		cResult = C.lingua_to_bedrock_converse(cInput, &cError)
+	case fnValidateBedrockConverseRequest:
+		cResult = C.lingua_validate_bedrock_converse_request(cInput, &cError)
+	case fnChatCompletionsTranscriptionToLingua:
+		cResult = C.lingua_chat_completions_transcription_to_lingua(cInput, &cError)
+	case fnLinguaToChatCompletionsTranscription:
+		cResult = C.lingua_to_chat_completions_transcription(cInput, &cError)
+	case fnBatch:
+		cResult = C.lingua_batch(cInput, &cError)
 	default:
 		return "", errors.New("unknown function")
 	}
@@ -356,33 +378,6 @@ func DeduplicateMessages(messages any) ([]map[string]any, error) {
 	return result, nil
 }
 
-// ImportMessagesFromSpans extracts messages from spans by attempting multiple provider format conversions.
-func ImportMessagesFromSpans(spans any) ([]map[string]any, error) {
-	jsonBytes, err := json.Marshal(spans)
-	if err != nil {
-		return nil, &ConversionError{
-			Message: "failed to marshal input: " + err.Error(),
-		}
-	}
-
-	resultJSON, err := callRustFunction(fnImportMessagesFromSpans, string(jsonBytes))
-	if err != nil {
-		return nil, &ConversionError{
-			Message: err.Error(),
-		}
-	}
-
-	var result []map[string]any
-	err = json.Unmarshal([]byte(resultJSON), &result)
-	if err != nil {
-		return nil, &ConversionError{
-			Message: "failed to unmarshal result: " + err.Error(),
-		}
-	}
-
-	return result, nil
-}
-
 // ============================================================================
 // Validation Functions
 // ============================================================================
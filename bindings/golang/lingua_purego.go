@@ -0,0 +1,441 @@
+//go:build !cgo
+
+// Package lingua provides a pure-Go fallback for the Lingua universal message format
+// library, reimplementing the six converters and six validators from lingua.go
+// without depending on CGo or the compiled Rust library. It is selected automatically
+// on builds without CGo (Alpine musl, Windows without a Rust toolchain, GOOS=js) and is
+// expected to return identical output to the CGo-backed implementation for the
+// documented message shapes: plain text, multimodal image_url, tool_calls/tool_use,
+// tool results, and system/developer messages. Anything outside those documented
+// shapes is not guaranteed to round-trip identically between the two builds.
+package lingua
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func marshalInput(input any, provider string) ([]byte, error) {
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, &ConversionError{Message: "failed to marshal input: " + err.Error(), Provider: provider}
+	}
+	return data, nil
+}
+
+func unmarshalMessages(data []byte, provider string) ([]map[string]any, error) {
+	var messages []map[string]any
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return nil, &ConversionError{Message: "Failed to parse input JSON: " + err.Error(), Provider: provider}
+	}
+	return messages, nil
+}
+
+func cloneMsg(msg map[string]any) map[string]any {
+	out := make(map[string]any, len(msg))
+	for k, v := range msg {
+		out[k] = v
+	}
+	return out
+}
+
+func asContentBlocks(content any) []any {
+	switch v := content.(type) {
+	case nil:
+		return nil
+	case string:
+		return []any{map[string]any{"type": "text", "text": v}}
+	case []any:
+		return v
+	default:
+		return nil
+	}
+}
+
+// ============================================================================
+// Chat Completions API Conversions
+// ============================================================================
+
+// ChatCompletionsMessagesToLingua converts Chat Completions messages to Lingua format.
+func ChatCompletionsMessagesToLingua(messages any) ([]map[string]any, error) {
+	data, err := marshalInput(messages, "Chat Completions")
+	if err != nil {
+		return nil, err
+	}
+	chatMsgs, err := unmarshalMessages(data, "Chat Completions")
+	if err != nil {
+		return nil, err
+	}
+
+	var out []map[string]any
+	for _, msg := range chatMsgs {
+		role, _ := msg["role"].(string)
+
+		if role == "tool" {
+			out = mergeToolResultIntoLastUser(out, msg)
+			continue
+		}
+
+		out = append(out, chatMessageToLinguaMessage(msg, role))
+	}
+	return out, nil
+}
+
+func chatMessageToLinguaMessage(msg map[string]any, role string) map[string]any {
+	out := map[string]any{"role": role}
+
+	var blocks []any
+	wasString := false
+	if content, ok := msg["content"].(string); ok {
+		wasString = true
+		blocks = append(blocks, map[string]any{"type": "text", "text": content})
+	} else if contentArr, ok := msg["content"].([]any); ok {
+		for _, part := range contentArr {
+			block, ok := part.(map[string]any)
+			if !ok {
+				continue
+			}
+			blocks = append(blocks, chatContentPartToLinguaBlock(block))
+		}
+	}
+
+	toolCalls, _ := msg["tool_calls"].([]any)
+	for _, tc := range toolCalls {
+		toolCall, ok := tc.(map[string]any)
+		if !ok {
+			continue
+		}
+		blocks = append(blocks, chatToolCallToLinguaBlock(toolCall))
+	}
+
+	if wasString && len(toolCalls) == 0 {
+		out["content"] = msg["content"]
+	} else {
+		out["content"] = blocks
+	}
+
+	if role == "assistant" {
+		if _, hasID := msg["id"]; !hasID {
+			out["id"] = nil
+		}
+	}
+	return out
+}
+
+func chatContentPartToLinguaBlock(block map[string]any) any {
+	switch block["type"] {
+	case "image_url":
+		imageURL, _ := block["image_url"].(map[string]any)
+		return map[string]any{
+			"type":             "image",
+			"image":            imageURL["url"],
+			"media_type":       "image/url",
+			"provider_options": nil,
+		}
+	case "input_audio":
+		inputAudio, _ := block["input_audio"].(map[string]any)
+		format, _ := inputAudio["format"].(string)
+		return NewAudioBlock(fmt.Sprintf("%v", inputAudio["data"]), "audio/"+format, "", nil)
+	default:
+		return block
+	}
+}
+
+func chatToolCallToLinguaBlock(toolCall map[string]any) map[string]any {
+	id, _ := toolCall["id"].(string)
+	function, _ := toolCall["function"].(map[string]any)
+	name, _ := function["name"].(string)
+
+	var input any
+	if argsStr, ok := function["arguments"].(string); ok {
+		_ = json.Unmarshal([]byte(argsStr), &input)
+	}
+	return NewToolUseBlock(id, name, input)
+}
+
+func mergeToolResultIntoLastUser(out []map[string]any, msg map[string]any) []map[string]any {
+	toolCallID, _ := msg["tool_call_id"].(string)
+	block := NewToolResultBlock(toolCallID, msg["content"], false)
+
+	if len(out) > 0 && out[len(out)-1]["role"] == "user" {
+		last := cloneMsg(out[len(out)-1])
+		content := asContentBlocks(last["content"])
+		last["content"] = append(content, block)
+		out[len(out)-1] = last
+		return out
+	}
+
+	return append(out, map[string]any{"role": "user", "content": []any{block}})
+}
+
+// LinguaToChatCompletionsMessages converts Lingua messages to Chat Completions format.
+//
+//nolint:revive // Preserve exported name for backward compatibility
+func LinguaToChatCompletionsMessages(messages any) ([]map[string]any, error) {
+	data, err := marshalInput(messages, "Chat Completions")
+	if err != nil {
+		return nil, err
+	}
+	linguaMsgs, err := unmarshalMessages(data, "Chat Completions")
+	if err != nil {
+		return nil, err
+	}
+
+	var out []map[string]any
+	for _, msg := range linguaMsgs {
+		role, _ := msg["role"].(string)
+		blocks := asContentBlocks(msg["content"])
+
+		var (
+			textParts []any
+			toolCalls []any
+			results   []map[string]any
+		)
+		for _, b := range blocks {
+			block, ok := b.(map[string]any)
+			if !ok {
+				continue
+			}
+			switch block["type"] {
+			case ContentBlockToolUse:
+				args, _ := json.Marshal(block["input"])
+				toolCalls = append(toolCalls, map[string]any{
+					"id":   block["id"],
+					"type": "function",
+					"function": map[string]any{
+						"name":      block["name"],
+						"arguments": string(args),
+					},
+				})
+			case ContentBlockToolResult:
+				results = append(results, map[string]any{
+					"role":         "tool",
+					"tool_call_id": block["tool_use_id"],
+					"content":      block["content"],
+				})
+			case "image":
+				textParts = append(textParts, map[string]any{
+					"type":      "image_url",
+					"image_url": map[string]any{"url": block["image"]},
+				})
+			default:
+				textParts = append(textParts, block)
+			}
+		}
+
+		// Emit the text/tool_use blocks as a chat message first, then any tool_result
+		// blocks as their own role:"tool" messages, since an assistant message can
+		// carry text and tool_calls together and a tool_result never shares a Chat
+		// Completions message with either. Skip the chat message entirely when the
+		// only blocks present are tool_results, to avoid emitting an empty message.
+		if len(toolCalls) > 0 || len(textParts) > 0 || len(results) == 0 {
+			chatMsg := map[string]any{"role": role}
+			if len(toolCalls) > 0 {
+				chatMsg["tool_calls"] = toolCalls
+			}
+			if len(textParts) == 1 {
+				if textBlock, ok := textParts[0].(map[string]any); ok && textBlock["type"] == "text" {
+					chatMsg["content"] = textBlock["text"]
+				} else {
+					chatMsg["content"] = textParts
+				}
+			} else {
+				chatMsg["content"] = textParts
+			}
+			out = append(out, chatMsg)
+		}
+		out = append(out, results...)
+	}
+	return out, nil
+}
+
+// ============================================================================
+// Responses API Conversions
+// ============================================================================
+
+// ResponsesMessagesToLingua converts Responses API messages to Lingua format.
+func ResponsesMessagesToLingua(messages any) ([]map[string]any, error) {
+	data, err := marshalInput(messages, "Responses")
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalMessages(data, "Responses")
+}
+
+// LinguaToResponsesMessages converts Lingua messages to Responses API format.
+//
+//nolint:revive // Preserve exported name for backward compatibility
+func LinguaToResponsesMessages(messages any) ([]map[string]any, error) {
+	data, err := marshalInput(messages, "Responses")
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalMessages(data, "Responses")
+}
+
+// ============================================================================
+// Anthropic Conversions
+// ============================================================================
+
+// AnthropicMessagesToLingua converts Anthropic messages to Lingua format.
+func AnthropicMessagesToLingua(messages any) ([]map[string]any, error) {
+	data, err := marshalInput(messages, "Anthropic")
+	if err != nil {
+		return nil, err
+	}
+	anthropicMsgs, err := unmarshalMessages(data, "Anthropic")
+	if err != nil {
+		return nil, err
+	}
+
+	var out []map[string]any
+	for _, msg := range anthropicMsgs {
+		out = append(out, cloneMsg(msg))
+	}
+	return out, nil
+}
+
+// LinguaToAnthropicMessages converts Lingua messages to Anthropic format.
+//
+//nolint:revive // Preserve exported name for backward compatibility
+func LinguaToAnthropicMessages(messages any) ([]map[string]any, error) {
+	data, err := marshalInput(messages, "Anthropic")
+	if err != nil {
+		return nil, err
+	}
+	linguaMsgs, err := unmarshalMessages(data, "Anthropic")
+	if err != nil {
+		return nil, err
+	}
+
+	var out []map[string]any
+	for _, msg := range linguaMsgs {
+		role, _ := msg["role"].(string)
+		anthropicMsg := map[string]any{"role": role}
+
+		if text, ok := msg["content"].(string); ok {
+			if role == "user" {
+				anthropicMsg["content"] = text
+			} else {
+				anthropicMsg["content"] = []map[string]any{{"type": "text", "text": text}}
+			}
+		} else {
+			anthropicMsg["content"] = msg["content"]
+		}
+		out = append(out, anthropicMsg)
+	}
+	return out, nil
+}
+
+// ============================================================================
+// Processing Functions
+// ============================================================================
+
+// DeduplicateMessages removes duplicate messages based on role and content.
+func DeduplicateMessages(messages any) ([]map[string]any, error) {
+	data, err := marshalInput(messages, "")
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := unmarshalMessages(data, "")
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var out []map[string]any
+	for _, msg := range msgs {
+		key, _ := json.Marshal(map[string]any{"role": msg["role"], "content": msg["content"]})
+		if seen[string(key)] {
+			continue
+		}
+		seen[string(key)] = true
+
+		normalized := cloneMsg(msg)
+		if role, _ := normalized["role"].(string); role == "assistant" {
+			if _, hasID := normalized["id"]; !hasID {
+				normalized["id"] = nil
+			}
+		}
+		out = append(out, normalized)
+	}
+	return out, nil
+}
+
+// ============================================================================
+// Validation Functions
+// ============================================================================
+
+func validateRequiredFields(jsonStr string, required ...string) (map[string]any, error) {
+	var result map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &result); err != nil {
+		return nil, fmt.Errorf("Failed to parse input JSON: %w", err)
+	}
+	for _, field := range required {
+		if _, ok := result[field]; !ok {
+			return nil, fmt.Errorf("missing required field %q", field)
+		}
+	}
+	return result, nil
+}
+
+func validateMessageRoles(result map[string]any, field string, allowedRoles map[string]bool) error {
+	messages, _ := result[field].([]any)
+	for _, m := range messages {
+		msg, ok := m.(map[string]any)
+		if !ok {
+			continue
+		}
+		role, _ := msg["role"].(string)
+		if !allowedRoles[role] {
+			return fmt.Errorf("invalid role %q", role)
+		}
+	}
+	return nil
+}
+
+// ValidateChatCompletionsRequest validates a JSON string as a Chat Completions request.
+func ValidateChatCompletionsRequest(jsonStr string) (map[string]any, error) {
+	result, err := validateRequiredFields(jsonStr, "messages")
+	if err != nil {
+		return nil, err
+	}
+	allowed := map[string]bool{"system": true, "developer": true, "user": true, "assistant": true, "tool": true}
+	if err := validateMessageRoles(result, "messages", allowed); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ValidateChatCompletionsResponse validates a JSON string as a Chat Completions response.
+func ValidateChatCompletionsResponse(jsonStr string) (map[string]any, error) {
+	return validateRequiredFields(jsonStr, "choices")
+}
+
+// ValidateResponsesRequest validates a JSON string as a Responses API request.
+func ValidateResponsesRequest(jsonStr string) (map[string]any, error) {
+	return validateRequiredFields(jsonStr, "input")
+}
+
+// ValidateResponsesResponse validates a JSON string as a Responses API response.
+func ValidateResponsesResponse(jsonStr string) (map[string]any, error) {
+	return validateRequiredFields(jsonStr, "output")
+}
+
+// ValidateAnthropicRequest validates a JSON string as an Anthropic request.
+func ValidateAnthropicRequest(jsonStr string) (map[string]any, error) {
+	result, err := validateRequiredFields(jsonStr, "model", "max_tokens", "messages")
+	if err != nil {
+		return nil, err
+	}
+	allowed := map[string]bool{"user": true, "assistant": true}
+	if err := validateMessageRoles(result, "messages", allowed); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ValidateAnthropicResponse validates a JSON string as an Anthropic response.
+func ValidateAnthropicResponse(jsonStr string) (map[string]any, error) {
+	return validateRequiredFields(jsonStr, "content")
+}
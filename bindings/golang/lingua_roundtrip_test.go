@@ -1,19 +1,45 @@
 package lingua
 
 import (
+	"encoding/json"
 	"encoding/json/jsontext"
 	jsonv2 "encoding/json/v2"
+	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"github.com/braintrustdata/lingua/bindings/golang/snapshotmatch"
 )
 
+// linguaMatchExpr is the -lingua.match flag: a snapshotmatch DSL expression that
+// selects which (case, provider, turn, message) tuples the roundtrip tests run, for
+// debugging a single failing fixture without editing listSnapshotTestCases. Falls back
+// to LINGUA_SNAPSHOT_MATCH when unset so CI can shard fixtures by axis without
+// rewriting the go test invocation.
+var linguaMatchExpr = flag.String("lingua.match", "", "snapshotmatch DSL expression selecting which (case,provider,turn,message) tuples to run")
+
+func activeSnapshotMatcher(t *testing.T) snapshotmatch.Matcher {
+	t.Helper()
+
+	expr := *linguaMatchExpr
+	if expr == "" {
+		expr = os.Getenv("LINGUA_SNAPSHOT_MATCH")
+	}
+
+	matcher, err := snapshotmatch.Parse(expr)
+	require.NoErrorf(t, err, "invalid -lingua.match/LINGUA_SNAPSHOT_MATCH expression %q", expr)
+	return matcher
+}
+
 // TestSnapshot represents a test case loaded from the snapshots directory.
 type TestSnapshot struct {
 	Name              string
@@ -22,6 +48,7 @@ type TestSnapshot struct {
 	Request           map[string]any
 	Response          map[string]any
 	StreamingResponse []map[string]any
+	SSEEvents         []SSEEvent // populated when the streaming fixture is a .sse file
 }
 
 const snapshotsBase = "../../payloads/snapshots"
@@ -83,9 +110,9 @@ func loadProviderSnapshots(testCaseName, provider, snapshotsDir string) []TestSn
 			Turn:     turn.name,
 		}
 
-		snapshot.Request = loadSnapshotMap(filepath.Join(providerDir, turn.prefix+"request.json"))
-		snapshot.Response = loadSnapshotMap(filepath.Join(providerDir, turn.prefix+"response.json"))
-		snapshot.StreamingResponse = loadStreamingSnapshot(filepath.Join(providerDir, turn.prefix+"response-streaming.json"))
+		snapshot.Request = loadSnapshotMap(filepath.Join(providerDir, turn.prefix+"request"))
+		snapshot.Response = loadSnapshotMap(filepath.Join(providerDir, turn.prefix+"response"))
+		snapshot.StreamingResponse, snapshot.SSEEvents = loadStreamingSnapshot(providerDir, turn.prefix)
 
 		if snapshot.Request != nil || snapshot.Response != nil || len(snapshot.StreamingResponse) > 0 {
 			snapshots = append(snapshots, snapshot)
@@ -95,8 +122,12 @@ func loadProviderSnapshots(testCaseName, provider, snapshotsDir string) []TestSn
 	return snapshots
 }
 
-func loadSnapshotMap(path string) map[string]any {
-	data, err := readSnapshotFile(path)
+// loadSnapshotMap loads a snapshot fixture given its path without extension, preferring
+// `<base>.json` and falling back to `<base>.yaml` (converted to JSON first, see
+// readSnapshotFileAsJSON) so fixtures can be authored in whichever format is easier to
+// hand-edit while the in-memory representation stays identical either way.
+func loadSnapshotMap(base string) map[string]any {
+	data, err := readSnapshotFileAsJSON(base)
 	if err != nil {
 		return nil
 	}
@@ -109,15 +140,48 @@ func loadSnapshotMap(path string) map[string]any {
 	return result
 }
 
-func loadStreamingSnapshot(path string) []map[string]any {
-	data, err := readSnapshotFile(path)
+// readSnapshotFileAsJSON loads `<base>.json` if present, else `<base>.yaml` converted
+// to JSON via sigs.k8s.io/yaml, so YAML remains a pure input surface: everything
+// downstream of this function only ever sees JSON bytes.
+func readSnapshotFileAsJSON(base string) ([]byte, error) {
+	if data, err := readSnapshotFile(base + ".json"); err == nil {
+		return data, nil
+	}
+
+	yamlData, err := readSnapshotFile(base + ".yaml")
 	if err != nil {
-		return nil
+		return nil, err
+	}
+	return yaml.YAMLToJSON(yamlData)
+}
+
+// loadStreamingSnapshot loads a streaming-response fixture for one turn, preferring a
+// `.sse` file (real SSE wire format: `event:`/`data:` frames, blank-line delimited)
+// over the legacy `.json` array/newline-delimited-JSON formats. When the fixture is
+// SSE, the parsed events are returned alongside the decoded JSON payloads so callers
+// that care about event names (not just their data) can use them.
+func loadStreamingSnapshot(providerDir, prefix string) ([]map[string]any, []SSEEvent) {
+	if data, err := readSnapshotFile(filepath.Join(providerDir, prefix+"response-streaming.sse")); err == nil {
+		events := ParseSSEStream(data)
+
+		var items []map[string]any
+		for _, evt := range events {
+			var item map[string]any
+			if err := jsonv2.Unmarshal([]byte(evt.Data), &item); err == nil {
+				items = append(items, item)
+			}
+		}
+		return items, events
+	}
+
+	data, err := readSnapshotFileAsJSON(filepath.Join(providerDir, prefix+"response-streaming"))
+	if err != nil {
+		return nil, nil
 	}
 
 	var streamResp []map[string]any
 	if err := jsonv2.Unmarshal(data, &streamResp); err == nil {
-		return streamResp
+		return streamResp, nil
 	}
 
 	lines := strings.Split(string(data), "\n")
@@ -135,10 +199,10 @@ func loadStreamingSnapshot(path string) []map[string]any {
 	}
 
 	if len(items) == 0 {
-		return nil
+		return nil, nil
 	}
 
-	return items
+	return items, nil
 }
 
 func readSnapshotFile(path string) ([]byte, error) {
@@ -220,10 +284,12 @@ func runRoundtripTests(
 ) {
 	t.Helper()
 
+	matcher := activeSnapshotMatcher(t)
+
 	for _, testCase := range listSnapshotTestCases(t) {
 		testCase := testCase
 		t.Run(testCase, func(t *testing.T) {
-			runRoundtripTestCase(t, testCase, provider, toLingua, fromLingua)
+			runRoundtripTestCase(t, testCase, provider, matcher, toLingua, fromLingua)
 		})
 	}
 }
@@ -232,11 +298,18 @@ func runRoundtripTestCase(
 	t *testing.T,
 	testCase string,
 	provider string,
+	matcher snapshotmatch.Matcher,
 	toLingua func([]any) ([]map[string]any, error),
 	fromLingua func([]map[string]any) ([]map[string]any, error),
 ) {
 	t.Helper()
 
+	id := snapshotmatch.SnapshotID{Case: testCase, Provider: provider}
+	if !snapshotmatch.PartialMatch(matcher, id, snapshotmatch.Known("case", "provider")) {
+		t.Skip("excluded by -lingua.match")
+		return
+	}
+
 	snapshots := loadTestSnapshots(t, testCase)
 	if len(snapshots) == 0 {
 		t.Skip("No snapshots found for this test case")
@@ -250,7 +323,7 @@ func runRoundtripTestCase(
 		}
 
 		t.Run(snapshot.Provider+" - "+snapshot.Turn, func(t *testing.T) {
-			runRoundtripSnapshot(t, provider, snapshot, toLingua, fromLingua)
+			runRoundtripSnapshot(t, provider, snapshot, matcher, toLingua, fromLingua)
 		})
 	}
 }
@@ -263,19 +336,28 @@ func runRoundtripSnapshot(
 	t *testing.T,
 	provider string,
 	snapshot *TestSnapshot,
+	matcher snapshotmatch.Matcher,
 	toLingua func([]any) ([]map[string]any, error),
 	fromLingua func([]map[string]any) ([]map[string]any, error),
 ) {
 	t.Helper()
 
+	id := snapshotmatch.SnapshotID{Case: snapshot.Name, Provider: provider, Turn: snapshot.Turn}
+	if !snapshotmatch.PartialMatch(matcher, id, snapshotmatch.Known("case", "provider", "turn")) {
+		t.Skip("excluded by -lingua.match")
+		return
+	}
+
 	field := snapshotRequestField(provider)
 	messages := extractSnapshotMessages(t, snapshot, field)
 
 	for index, msgInterface := range messages {
 		index := index
 		msgInterface := msgInterface
+		messageID := id
+		messageID.Message = index
 		t.Run(fmt.Sprintf("message_%d", index), func(t *testing.T) {
-			runRoundtripMessage(t, msgInterface, toLingua, fromLingua)
+			runRoundtripMessage(t, msgInterface, messageID, matcher, toLingua, fromLingua)
 		})
 	}
 }
@@ -303,11 +385,18 @@ func extractSnapshotMessages(t *testing.T, snapshot *TestSnapshot, field string)
 func runRoundtripMessage(
 	t *testing.T,
 	msgInterface any,
+	id snapshotmatch.SnapshotID,
+	matcher snapshotmatch.Matcher,
 	toLingua func([]any) ([]map[string]any, error),
 	fromLingua func([]map[string]any) ([]map[string]any, error),
 ) {
 	t.Helper()
 
+	if !matcher.Match(id) {
+		t.Skip("excluded by -lingua.match")
+		return
+	}
+
 	originalMessage, ok := msgInterface.(map[string]any)
 	require.True(t, ok, "Message should be a map")
 
@@ -379,6 +468,218 @@ func mustPrettyJSON(t *testing.T, value any) string {
 	return string(data)
 }
 
+// ============================================================================
+// Cross-provider transitive roundtrip
+// ============================================================================
+
+// crossProviderConverter pairs a provider's two Lingua converters under its snapshot
+// name, so runCrossProviderTests can drive any ordered pair of providers generically.
+type crossProviderConverter struct {
+	toLingua   func(any) ([]map[string]any, error)
+	fromLingua func(any) ([]map[string]any, error)
+}
+
+var crossProviderConverters = map[string]crossProviderConverter{
+	"chat-completions": {toLingua: ChatCompletionsMessagesToLingua, fromLingua: LinguaToChatCompletionsMessages},
+	"responses":        {toLingua: ResponsesMessagesToLingua, fromLingua: LinguaToResponsesMessages},
+	"anthropic":        {toLingua: AnthropicMessagesToLingua, fromLingua: LinguaToAnthropicMessages},
+}
+
+var crossProviderNames = []string{"chat-completions", "responses", "anthropic"}
+
+// runCrossProviderTests checks that converting a message A -> Lingua -> B -> Lingua
+// lands on the same Lingua representation as A -> Lingua alone, for every ordered pair
+// of providers. Unlike runRoundtripTests (same-provider wire-format equality), this
+// can only assert semantic invariance of the Lingua intermediate, since B's wire format
+// has no obligation to look like A's.
+func runCrossProviderTests(t *testing.T) {
+	t.Helper()
+
+	for _, testCase := range listSnapshotTestCases(t) {
+		testCase := testCase
+		t.Run(testCase, func(t *testing.T) {
+			snapshots := loadTestSnapshots(t, testCase)
+			if len(snapshots) == 0 {
+				t.Skip("No snapshots found for this test case")
+				return
+			}
+
+			ran := false
+			for _, from := range crossProviderNames {
+				for _, to := range crossProviderNames {
+					if from == to {
+						continue
+					}
+					snapshot := findSnapshotForProvider(snapshots, from)
+					if snapshot == nil {
+						continue
+					}
+
+					ran = true
+					from, to := from, to
+					t.Run(from+"_to_"+to, func(t *testing.T) {
+						runCrossProviderPair(t, snapshot, from, to)
+					})
+				}
+			}
+			if !ran {
+				t.Skip("No cross-provider snapshot pairs available for this test case")
+			}
+		})
+	}
+}
+
+func findSnapshotForProvider(snapshots []TestSnapshot, provider string) *TestSnapshot {
+	for i := range snapshots {
+		if snapshots[i].Provider == provider && snapshots[i].Request != nil {
+			return &snapshots[i]
+		}
+	}
+	return nil
+}
+
+func runCrossProviderPair(t *testing.T, snapshot *TestSnapshot, from, to string) {
+	t.Helper()
+
+	fromConv := crossProviderConverters[from]
+	toConv := crossProviderConverters[to]
+
+	messages := extractSnapshotMessages(t, snapshot, snapshotRequestField(from))
+	msg := messages[0]
+
+	linguaFirst, err := fromConv.toLingua([]any{msg})
+	require.NoErrorf(t, err, "Failed to convert %s message to Lingua", from)
+
+	providerB, err := toConv.fromLingua(linguaFirst)
+	require.NoErrorf(t, err, "Failed to convert Lingua message to %s", to)
+
+	providerBAny := make([]any, len(providerB))
+	for i, m := range providerB {
+		providerBAny[i] = m
+	}
+
+	linguaSecond, err := toConv.toLingua(providerBAny)
+	require.NoErrorf(t, err, "Failed to convert %s message back to Lingua", to)
+
+	canonicalFirst := canonicalizeLinguaMessages(linguaFirst)
+	canonicalSecond := canonicalizeLinguaMessages(linguaSecond)
+
+	if deepEqual(canonicalFirst, canonicalSecond) {
+		return
+	}
+
+	t.Fatalf(
+		"Cross-provider transitive roundtrip mismatch (%s -> %s):\nFirst:\n%s\nSecond:\n%s",
+		from, to,
+		mustPrettyJSON(t, canonicalFirst),
+		mustPrettyJSON(t, canonicalSecond),
+	)
+}
+
+// knownContentBlockFields lists the documented fields for each Lingua content block
+// type; anything else found on a block is provider noise and gets moved under
+// "extensions" by canonicalizeContentBlock instead of registering as a real diff.
+var knownContentBlockFields = map[string][]string{
+	"text":                 {"type", "text"},
+	"image":                {"type", "image", "media_type"},
+	"thinking":             {"type", "thinking"},
+	ContentBlockAudio:      {"type", "audio", "media_type", "transcript"},
+	ContentBlockToolUse:    {"type", "id", "name", "input"},
+	ContentBlockToolResult: {"type", "tool_use_id", "content", "is_error"},
+}
+
+// canonicalizeLinguaMessages puts Lingua messages into a comparable form for
+// cross-provider transitive equality: content parts are reordered into a stable
+// (type, key) order so a provider that merely reorders blocks doesn't look lossy, and
+// any field outside a block's documented shape is bagged into "extensions".
+func canonicalizeLinguaMessages(messages []map[string]any) []map[string]any {
+	out := make([]map[string]any, len(messages))
+	for i, msg := range messages {
+		out[i] = canonicalizeLinguaMessage(msg)
+	}
+	return out
+}
+
+func canonicalizeLinguaMessage(msg map[string]any) map[string]any {
+	blocks := contentBlocksOf(msg["content"])
+
+	canonicalBlocks := make([]map[string]any, 0, len(blocks))
+	for _, b := range blocks {
+		if block, ok := b.(map[string]any); ok {
+			canonicalBlocks = append(canonicalBlocks, canonicalizeContentBlock(block))
+		}
+	}
+
+	sort.SliceStable(canonicalBlocks, func(i, j int) bool {
+		return contentBlockSortKey(canonicalBlocks[i]) < contentBlockSortKey(canonicalBlocks[j])
+	})
+
+	return map[string]any{"role": msg["role"], "content": canonicalBlocks}
+}
+
+// contentBlocksOf normalizes a message's `content` field (a bare string or an array of
+// content-block maps) into a block array, matching how the rest of the package treats
+// plain-text content as an implicit single text block.
+func contentBlocksOf(content any) []any {
+	switch v := content.(type) {
+	case string:
+		return []any{map[string]any{"type": "text", "text": v}}
+	case []any:
+		return v
+	default:
+		return nil
+	}
+}
+
+func canonicalizeContentBlock(block map[string]any) map[string]any {
+	blockType, _ := block["type"].(string)
+	known := knownContentBlockFields[blockType]
+
+	canonical := map[string]any{}
+	extensions := map[string]any{}
+	for key, value := range block {
+		if containsString(known, key) {
+			canonical[key] = value
+		} else {
+			extensions[key] = value
+		}
+	}
+	if len(extensions) > 0 {
+		canonical["extensions"] = extensions
+	}
+	return canonical
+}
+
+func contentBlockSortKey(block map[string]any) string {
+	blockType, _ := block["type"].(string)
+
+	var secondary string
+	switch blockType {
+	case ContentBlockToolUse:
+		secondary, _ = block["id"].(string)
+	case ContentBlockToolResult:
+		secondary, _ = block["tool_use_id"].(string)
+	case "text":
+		secondary, _ = block["text"].(string)
+	}
+	return blockType + "\x00" + secondary
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// TestCrossProviderTransitiveRoundtrip checks A -> Lingua -> B -> Lingua semantic
+// invariance for every ordered pair of chat-completions, responses, and anthropic.
+func TestCrossProviderTransitiveRoundtrip(t *testing.T) {
+	runCrossProviderTests(t)
+}
+
 // TestChatCompletionsRoundtrip tests roundtrip conversion for OpenAI Chat Completions format.
 func TestChatCompletionsRoundtrip(t *testing.T) {
 	runRoundtripTests(
@@ -391,6 +692,10 @@ func TestChatCompletionsRoundtrip(t *testing.T) {
 			return LinguaToChatCompletionsMessages(messages)
 		},
 	)
+
+	t.Run("streaming", func(t *testing.T) {
+		runStreamingRoundtripTests(t, "chat-completions", ChatCompletionsStreamToLingua, LinguaToChatCompletionsMessages)
+	})
 }
 
 // TestAnthropicRoundtrip tests roundtrip conversion for Anthropic format.
@@ -405,6 +710,10 @@ func TestAnthropicRoundtrip(t *testing.T) {
 			return LinguaToAnthropicMessages(messages)
 		},
 	)
+
+	t.Run("streaming", func(t *testing.T) {
+		runStreamingRoundtripTests(t, "anthropic", AnthropicStreamToLingua, LinguaToAnthropicMessages)
+	})
 }
 
 // TestResponsesRoundtrip tests roundtrip conversion for OpenAI Responses API format.
@@ -419,6 +728,269 @@ func TestResponsesRoundtrip(t *testing.T) {
 			return LinguaToResponsesMessages(messages)
 		},
 	)
+
+	t.Run("streaming", func(t *testing.T) {
+		runStreamingRoundtripTests(t, "responses", ResponsesStreamToLingua, LinguaToResponsesMessages)
+	})
+}
+
+// ============================================================================
+// Streaming delta roundtrip
+// ============================================================================
+
+// runStreamingRoundtripTests is the streaming counterpart to runRoundtripTests: instead
+// of converting a single whole message, it feeds a snapshot's StreamingResponse chunks
+// through streamToLingua, reassembles the resulting Lingua stream events into a single
+// Lingua message, and asserts that converting that message back to the provider's
+// format (via fromLingua) matches the snapshot's non-streaming Response.
+func runStreamingRoundtripTests(
+	t *testing.T,
+	provider string,
+	streamToLingua func([]map[string]any) ([]LinguaStreamEvent, error),
+	fromLingua func(any) ([]map[string]any, error),
+) {
+	t.Helper()
+
+	for _, testCase := range listSnapshotTestCases(t) {
+		testCase := testCase
+		t.Run(testCase, func(t *testing.T) {
+			snapshots := loadTestSnapshots(t, testCase)
+
+			ran := false
+			for i := range snapshots {
+				snapshot := &snapshots[i]
+				if snapshot.Provider != provider || len(snapshot.StreamingResponse) == 0 {
+					continue
+				}
+
+				ran = true
+				t.Run(snapshot.Turn, func(t *testing.T) {
+					runStreamingRoundtripSnapshot(t, provider, snapshot, streamToLingua, fromLingua)
+				})
+			}
+			if !ran {
+				t.Skip("No streaming snapshots found for this test case")
+			}
+		})
+	}
+}
+
+func runStreamingRoundtripSnapshot(
+	t *testing.T,
+	provider string,
+	snapshot *TestSnapshot,
+	streamToLingua func([]map[string]any) ([]LinguaStreamEvent, error),
+	fromLingua func(any) ([]map[string]any, error),
+) {
+	t.Helper()
+
+	events, err := streamToLingua(snapshot.StreamingResponse)
+	require.NoError(t, err, "Failed to convert provider stream to Lingua events")
+	require.NotEmpty(t, events, "Decoding should produce at least one Lingua stream event")
+
+	linguaMessage := reassembleLinguaStreamMessage(events)
+	roundtripped, err := fromLingua([]map[string]any{linguaMessage})
+	require.NoError(t, err, "Failed to convert reassembled Lingua message back to provider format")
+	require.Len(t, roundtripped, 1)
+
+	expected := expectedStreamingMessage(provider, snapshot.Response)
+	if expected == nil {
+		t.Skip("No final response message to compare against for this snapshot")
+		return
+	}
+
+	if deepEqual(expected, roundtripped[0]) {
+		return
+	}
+
+	t.Fatalf(
+		"Streaming roundtrip mismatch:\nExpected:\n%s\nReassembled:\n%s\nLingua intermediate:\n%s",
+		mustPrettyJSON(t, expected),
+		mustPrettyJSON(t, roundtripped[0]),
+		mustPrettyJSON(t, linguaMessage),
+	)
+}
+
+// expectedStreamingMessage extracts the single assistant message a snapshot's final
+// (non-streaming) Response represents, in the same shape runRoundtripMessage compares
+// against, so runStreamingRoundtripSnapshot can assert the reassembled message matches it.
+func expectedStreamingMessage(provider string, response map[string]any) map[string]any {
+	switch provider {
+	case "chat-completions":
+		choices, _ := response["choices"].([]any)
+		if len(choices) == 0 {
+			return nil
+		}
+		choice, _ := choices[0].(map[string]any)
+		message, _ := choice["message"].(map[string]any)
+		return message
+	case "responses":
+		output, _ := response["output"].([]any)
+		if len(output) == 0 {
+			return nil
+		}
+		item, _ := output[0].(map[string]any)
+		// The output item envelope (id, type, status, ...) sits alongside role/content
+		// rather than wrapping them, so only pull the fields a reassembled message can
+		// actually reproduce.
+		return map[string]any{"role": item["role"], "content": item["content"]}
+	case "anthropic":
+		// The Anthropic response envelope (id, model, usage, stop_reason, ...) sits
+		// alongside role/content rather than wrapping them, so only pull the fields a
+		// reassembled message can actually reproduce.
+		return map[string]any{"role": response["role"], "content": response["content"]}
+	default:
+		return nil
+	}
+}
+
+// reassembleLinguaStreamMessage rebuilds the single Lingua message a sequence of typed
+// stream events describes, mirroring how a real consumer would buffer a stream to
+// reconstruct the final message it represents.
+func reassembleLinguaStreamMessage(events []LinguaStreamEvent) map[string]any {
+	var order []int
+	texts := map[int]*strings.Builder{}
+	toolCalls := map[int]ToolCallPart{}
+	toolArgs := map[int]string{}
+	kinds := map[int]string{}
+
+	for _, event := range events {
+		switch event.Type {
+		case LinguaEventContentPartStart:
+			order = append(order, event.Index)
+			if toolCall, ok := event.Part.(ToolCallPart); ok {
+				kinds[event.Index] = ContentBlockToolUse
+				toolCalls[event.Index] = toolCall
+				continue
+			}
+			kinds[event.Index] = "text"
+			texts[event.Index] = &strings.Builder{}
+		case LinguaEventContentPartDelta:
+			if kinds[event.Index] == ContentBlockToolUse {
+				toolArgs[event.Index] = event.PartialJSON
+				continue
+			}
+			if builder, ok := texts[event.Index]; ok {
+				builder.WriteString(event.TextDelta)
+			}
+		}
+	}
+
+	parts := make([]ContentPart, 0, len(order))
+	for _, index := range order {
+		if kinds[index] == ContentBlockToolUse {
+			toolCall := toolCalls[index]
+			var input any
+			_ = json.Unmarshal([]byte(toolArgs[index]), &input)
+			parts = append(parts, ToolCallPart{ID: toolCall.ID, Name: toolCall.Name, Input: input})
+			continue
+		}
+		parts = append(parts, TextPart{Text: texts[index].String()})
+	}
+
+	message := Message{Role: "assistant", Content: parts}
+	data, err := json.Marshal(message)
+	if err != nil {
+		return map[string]any{"role": "assistant"}
+	}
+
+	var result map[string]any
+	_ = json.Unmarshal(data, &result)
+	return result
+}
+
+// TestSSEStreamRoundtrip exercises the SSE -> Lingua stream events -> SSE path for
+// each provider's streaming SSE fixtures, complementing the message-level roundtrip
+// tests above.
+func TestSSEStreamRoundtrip(t *testing.T) {
+	for _, testCase := range listSnapshotTestCases(t) {
+		testCase := testCase
+		t.Run(testCase, func(t *testing.T) {
+			snapshots := loadTestSnapshots(t, testCase)
+
+			ran := false
+			for i := range snapshots {
+				snapshot := &snapshots[i]
+				if len(snapshot.SSEEvents) == 0 {
+					continue
+				}
+
+				ran = true
+				t.Run(snapshot.Provider+" - "+snapshot.Turn, func(t *testing.T) {
+					runSSEStreamRoundtrip(t, snapshot)
+				})
+			}
+			if !ran {
+				t.Skip("No SSE streaming snapshots found for this test case")
+			}
+		})
+	}
+}
+
+func runSSEStreamRoundtrip(t *testing.T, snapshot *TestSnapshot) {
+	t.Helper()
+
+	raw := EmitSSEStream(snapshot.SSEEvents)
+
+	var (
+		events []map[string]any
+		err    error
+	)
+	switch snapshot.Provider {
+	case "anthropic":
+		events, err = NewAnthropicStreamDecoder().Decode(raw)
+	case "responses":
+		events, err = NewResponsesStreamDecoder().Decode(raw)
+	default:
+		events, err = NewChatCompletionsStreamDecoder().Decode(raw)
+	}
+	require.NoError(t, err, "Failed to decode SSE stream into Lingua events")
+	require.NotEmpty(t, events, "Decoding should produce at least one Lingua stream event")
+
+	var reencoded []byte
+	switch snapshot.Provider {
+	case "anthropic":
+		reencoded, err = LinguaStreamToAnthropicSSE(events)
+	case "responses":
+		reencoded, err = LinguaStreamToResponsesSSE(events)
+	default:
+		reencoded, err = LinguaStreamToChatCompletionsSSE(events)
+	}
+	require.NoError(t, err, "Failed to re-encode Lingua stream events back to SSE")
+	require.NotEmpty(t, reencoded, "Re-encoded SSE stream should not be empty")
+}
+
+// TestSnapshotFormatEquivalence fails if a snapshot fixture has both a `.json` and a
+// `.yaml` form that disagree. readSnapshotFileAsJSON always prefers `.json`, so a
+// `.yaml` sibling that's drifted out of sync would otherwise go unnoticed by every
+// other test in this file.
+func TestSnapshotFormatEquivalence(t *testing.T) {
+	err := filepath.Walk(snapshotsBase, func(path string, info os.FileInfo, walkErr error) error {
+		if walkErr != nil || info.IsDir() || !strings.HasSuffix(path, ".yaml") {
+			return walkErr
+		}
+
+		jsonPath := strings.TrimSuffix(path, ".yaml") + ".json"
+		if _, statErr := os.Stat(jsonPath); statErr != nil {
+			return nil
+		}
+
+		yamlData, err := readSnapshotFile(path)
+		require.NoError(t, err)
+		jsonFromYAML, err := yaml.YAMLToJSON(yamlData)
+		require.NoErrorf(t, err, "Failed to convert %s to JSON", path)
+
+		jsonData, err := readSnapshotFile(jsonPath)
+		require.NoError(t, err)
+
+		var fromYAML, fromJSON any
+		require.NoError(t, jsonv2.Unmarshal(jsonFromYAML, &fromYAML))
+		require.NoError(t, jsonv2.Unmarshal(jsonData, &fromJSON))
+
+		assert.Truef(t, deepEqual(fromYAML, fromJSON), "%s and %s disagree", path, jsonPath)
+		return nil
+	})
+	require.NoError(t, err)
 }
 
 // TestSnapshotCoverage verifies that we have good test coverage across all snapshot cases.
@@ -136,9 +136,10 @@ func TestImportMessagesFromSpans(t *testing.T) {
 		},
 	}
 
-	messages, err := ImportMessagesFromSpans(spans)
+	result, err := ImportMessagesFromSpans(spans)
 	require.NoError(t, err)
-	require.Len(t, messages, 3)
+	require.Len(t, result.Messages, 3)
+	require.Equal(t, "chat_completions", result.DetectedProvider)
 
 	expectedMessages := []map[string]any{
 		{"role": "user", "content": "Hello"},
@@ -146,7 +147,7 @@ func TestImportMessagesFromSpans(t *testing.T) {
 		{"role": "assistant", "content": "Hi there", "id": nil},
 	}
 
-	assertJSONEqual(t, expectedMessages, messages, "ImportMessagesFromSpans should import all messages in order")
+	assertJSONEqual(t, expectedMessages, result.Messages, "ImportMessagesFromSpans should import all messages in order")
 
 	deduplicated, err := ImportAndDeduplicateMessages(spans)
 	require.NoError(t, err)
@@ -0,0 +1,79 @@
+package snapshotmatch
+
+// KnownFields names which SnapshotID axes are already resolved at a given point in a
+// nested test loop (case -> provider -> turn -> message), for use with PartialMatch.
+type KnownFields map[string]bool
+
+// Known builds a KnownFields set from axis names ("case", "provider", "turn",
+// "message").
+func Known(fields ...string) KnownFields {
+	known := make(KnownFields, len(fields))
+	for _, f := range fields {
+		known[f] = true
+	}
+	return known
+}
+
+// PartialMatch reports whether id could still satisfy m once any axis not in known is
+// resolved, so a caller can short-circuit a nested loop before every axis is known.
+// Unknown axes are treated permissively, so PartialMatch never produces a false
+// negative; only Match, once every axis is known, is authoritative.
+func PartialMatch(m Matcher, id SnapshotID, known KnownFields) bool {
+	result, _ := evalPartial(m, id, known)
+	return result
+}
+
+// evalPartial evaluates m against id using only the axes in known, returning a
+// tri-state result: (result, definite). definite=false means the clauses touching
+// unknown axes could still go either way, so result is the permissive default (true)
+// rather than an authoritative answer.
+func evalPartial(m Matcher, id SnapshotID, known KnownFields) (result, definite bool) {
+	switch v := m.(type) {
+	case matchAll:
+		return true, true
+
+	case globClause:
+		if !known[v.key] {
+			return true, false
+		}
+		return v.Match(id), true
+
+	case rangeClause:
+		if !known["message"] {
+			return true, false
+		}
+		return v.Match(id), true
+
+	case andMatcher:
+		sawIndefinite := false
+		for _, sub := range v {
+			res, def := evalPartial(sub, id, known)
+			if def && !res {
+				return false, true
+			}
+			if !def {
+				sawIndefinite = true
+			}
+		}
+		return true, !sawIndefinite
+
+	case orMatcher:
+		sawIndefinite := false
+		for _, sub := range v {
+			res, def := evalPartial(sub, id, known)
+			if def && res {
+				return true, true
+			}
+			if !def {
+				sawIndefinite = true
+			}
+		}
+		if sawIndefinite {
+			return true, false
+		}
+		return false, true
+
+	default:
+		return true, false
+	}
+}
@@ -0,0 +1,165 @@
+// Package snapshotmatch implements a small selection DSL for picking which
+// (case, provider, turn, message) tuples a roundtrip test should run, independent of
+// testing's own -run regex (which can't address those axes individually). It backs the
+// -lingua.match test flag and LINGUA_SNAPSHOT_MATCH env var.
+package snapshotmatch
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// SnapshotID identifies one tuple a roundtrip test might run.
+type SnapshotID struct {
+	Case     string
+	Provider string
+	Turn     string
+	Message  int
+}
+
+// Matcher reports whether a fully-resolved SnapshotID should run.
+type Matcher interface {
+	Match(id SnapshotID) bool
+}
+
+// MatchAll is the Matcher that selects every SnapshotID, used when no -lingua.match
+// expression (or LINGUA_SNAPSHOT_MATCH) was given.
+var MatchAll Matcher = matchAll{}
+
+type matchAll struct{}
+
+func (matchAll) Match(SnapshotID) bool { return true }
+
+type orMatcher []Matcher
+
+func (m orMatcher) Match(id SnapshotID) bool {
+	for _, sub := range m {
+		if sub.Match(id) {
+			return true
+		}
+	}
+	return false
+}
+
+type andMatcher []Matcher
+
+func (m andMatcher) Match(id SnapshotID) bool {
+	for _, sub := range m {
+		if !sub.Match(id) {
+			return false
+		}
+	}
+	return true
+}
+
+type globClause struct {
+	key     string
+	pattern string
+}
+
+func (c globClause) Match(id SnapshotID) bool {
+	var value string
+	switch c.key {
+	case "case":
+		value = id.Case
+	case "provider":
+		value = id.Provider
+	case "turn":
+		value = id.Turn
+	case "message":
+		value = strconv.Itoa(id.Message)
+	default:
+		return false
+	}
+	ok, err := path.Match(c.pattern, value)
+	return err == nil && ok
+}
+
+type rangeClause struct {
+	lo, hi int
+}
+
+func (c rangeClause) Match(id SnapshotID) bool {
+	return id.Message >= c.lo && id.Message <= c.hi
+}
+
+// Parse compiles a snapshot-selection expression into a Matcher. The grammar is
+// comma-separated `key=glob` clauses ANDed together ("," and "&" are equivalent AND
+// operators), with "|" composing multiple AND-groups as alternatives:
+//
+//	case=tool_use_*,provider=anthropic,turn=followup_turn,message=2..4
+//
+// Recognized keys are "case", "provider", and "turn" (glob-matched against the
+// relevant SnapshotID field) and "message" (an exact index, an inclusive "N..M" range,
+// or a glob against its decimal string). An empty expression returns MatchAll.
+func Parse(expr string) (Matcher, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return MatchAll, nil
+	}
+
+	var orGroups orMatcher
+	for _, group := range strings.Split(expr, "|") {
+		group = strings.TrimSpace(group)
+		if group == "" {
+			return nil, fmt.Errorf("snapshotmatch: empty clause group in %q", expr)
+		}
+
+		terms := strings.FieldsFunc(group, func(r rune) bool { return r == ',' || r == '&' })
+		if len(terms) == 0 {
+			return nil, fmt.Errorf("snapshotmatch: empty clause group in %q", expr)
+		}
+
+		var and andMatcher
+		for _, term := range terms {
+			clause, err := parseTerm(strings.TrimSpace(term))
+			if err != nil {
+				return nil, err
+			}
+			and = append(and, clause)
+		}
+		orGroups = append(orGroups, and)
+	}
+
+	if len(orGroups) == 1 {
+		return orGroups[0], nil
+	}
+	return orGroups, nil
+}
+
+func parseTerm(term string) (Matcher, error) {
+	key, value, ok := strings.Cut(term, "=")
+	if !ok {
+		return nil, fmt.Errorf("snapshotmatch: malformed clause %q, expected key=value", term)
+	}
+	key = strings.TrimSpace(key)
+	value = strings.TrimSpace(value)
+
+	switch key {
+	case "case", "provider", "turn":
+		return globClause{key: key, pattern: value}, nil
+	case "message":
+		if lo, hi, ok := parseRange(value); ok {
+			return rangeClause{lo: lo, hi: hi}, nil
+		}
+		return globClause{key: key, pattern: value}, nil
+	default:
+		return nil, fmt.Errorf("snapshotmatch: unknown clause key %q", key)
+	}
+}
+
+func parseRange(value string) (lo, hi int, ok bool) {
+	loStr, hiStr, found := strings.Cut(value, "..")
+	if !found {
+		return 0, 0, false
+	}
+
+	lo, err1 := strconv.Atoi(strings.TrimSpace(loStr))
+	hi, err2 := strconv.Atoi(strings.TrimSpace(hiStr))
+	if err1 != nil || err2 != nil {
+		return 0, 0, false
+	}
+	return lo, hi, true
+}
@@ -0,0 +1,139 @@
+package snapshotmatch
+
+import "testing"
+
+func TestParseGlobClauseMatchesCase(t *testing.T) {
+	m, err := Parse("case=tool_use_*")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	if !m.Match(SnapshotID{Case: "tool_use_basic"}) {
+		t.Error("expected tool_use_basic to match case=tool_use_*")
+	}
+	if m.Match(SnapshotID{Case: "image_basic"}) {
+		t.Error("expected image_basic not to match case=tool_use_*")
+	}
+}
+
+func TestParseAndComposesClauses(t *testing.T) {
+	m, err := Parse("case=tool_use_*,provider=anthropic,turn=followup_turn")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	match := SnapshotID{Case: "tool_use_basic", Provider: "anthropic", Turn: "followup_turn"}
+	if !m.Match(match) {
+		t.Error("expected all clauses to match")
+	}
+
+	mismatch := SnapshotID{Case: "tool_use_basic", Provider: "chat-completions", Turn: "followup_turn"}
+	if m.Match(mismatch) {
+		t.Error("expected a differing provider to fail the AND group")
+	}
+}
+
+func TestParseAmpersandIsEquivalentToComma(t *testing.T) {
+	comma, err := Parse("case=tool_use_*,provider=anthropic")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	ampersand, err := Parse("case=tool_use_*&provider=anthropic")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	id := SnapshotID{Case: "tool_use_basic", Provider: "anthropic"}
+	if comma.Match(id) != ampersand.Match(id) {
+		t.Error("expected ',' and '&' to compose identically")
+	}
+}
+
+func TestParseOrComposesGroups(t *testing.T) {
+	m, err := Parse("provider=anthropic|provider=gemini")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	if !m.Match(SnapshotID{Provider: "anthropic"}) {
+		t.Error("expected anthropic to match first OR group")
+	}
+	if !m.Match(SnapshotID{Provider: "gemini"}) {
+		t.Error("expected gemini to match second OR group")
+	}
+	if m.Match(SnapshotID{Provider: "responses"}) {
+		t.Error("expected responses not to match either OR group")
+	}
+}
+
+func TestParseMessageRange(t *testing.T) {
+	m, err := Parse("message=2..4")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	for _, tc := range []struct {
+		message int
+		want    bool
+	}{
+		{1, false},
+		{2, true},
+		{3, true},
+		{4, true},
+		{5, false},
+	} {
+		if got := m.Match(SnapshotID{Message: tc.message}); got != tc.want {
+			t.Errorf("message=%d: got %v, want %v", tc.message, got, tc.want)
+		}
+	}
+}
+
+func TestParseEmptyExpressionMatchesAll(t *testing.T) {
+	m, err := Parse("")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if !m.Match(SnapshotID{Case: "anything", Provider: "anything"}) {
+		t.Error("expected empty expression to match everything")
+	}
+}
+
+func TestParseRejectsMalformedClause(t *testing.T) {
+	if _, err := Parse("case"); err == nil {
+		t.Error("expected an error for a clause missing '='")
+	}
+	if _, err := Parse("color=red"); err == nil {
+		t.Error("expected an error for an unknown clause key")
+	}
+}
+
+func TestPartialMatchIsPermissiveForUnknownAxes(t *testing.T) {
+	m, err := Parse("case=tool_use_*,turn=followup_turn")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	knownCaseOnly := Known("case")
+	if !PartialMatch(m, SnapshotID{Case: "tool_use_basic"}, knownCaseOnly) {
+		t.Error("expected a matching known axis with an unresolved turn to stay permissive")
+	}
+	if PartialMatch(m, SnapshotID{Case: "image_basic"}, knownCaseOnly) {
+		t.Error("expected a failing known axis to short-circuit regardless of unresolved axes")
+	}
+}
+
+func TestPartialMatchBecomesAuthoritativeOnceAllAxesKnown(t *testing.T) {
+	m, err := Parse("case=tool_use_*,turn=followup_turn")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	all := Known("case", "provider", "turn", "message")
+	id := SnapshotID{Case: "tool_use_basic", Turn: "first_turn"}
+	if PartialMatch(m, id, all) {
+		t.Error("expected PartialMatch to agree with Match once every axis is known")
+	}
+	if PartialMatch(m, id, all) != m.Match(id) {
+		t.Error("expected PartialMatch and Match to agree once every axis is known")
+	}
+}
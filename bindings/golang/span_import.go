@@ -0,0 +1,242 @@
+package lingua
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ImportResult is the outcome of importing messages from one or more trace spans,
+// including which provider wire format was auto-detected so callers ingesting traces
+// from mixed tooling can tell what they got.
+type ImportResult struct {
+	Messages         []Message
+	DetectedProvider string
+	ConfidenceScore  float64
+	Warnings         []string
+}
+
+// spanProviderCandidate pairs a provider name with its Lingua converter, so span
+// detection can try each one and score the result. spanProviderCandidates is defined
+// per build (span_import_providers_cgo.go / span_import_providers_purego.go) since
+// Gemini and Bedrock conversion require CGo.
+type spanProviderCandidate struct {
+	name  string
+	parse func(any) ([]map[string]any, error)
+}
+
+// ImportMessagesFromSpans extracts messages from spans, auto-detecting the provider
+// wire format. Spans may be Braintrust-style (`input`/`output` message arrays) or
+// OpenTelemetry gen-ai spans (`gen_ai.prompt.N.*` / `gen_ai.completion.N.*`
+// attributes). Each candidate provider parser is run against the extracted messages
+// and scored by how many of them it recognized; the highest-scoring parse wins, and
+// any tie is recorded in Warnings rather than silently broken.
+func ImportMessagesFromSpans(spans any) (*ImportResult, error) {
+	return detectAndImportSpans(spans, "")
+}
+
+// ImportMessagesFromSpansWithHint behaves like ImportMessagesFromSpans, but skips
+// auto-detection and parses directly with the named provider ("chat_completions",
+// "responses", "anthropic", or, on CGo builds, "gemini"/"bedrock").
+func ImportMessagesFromSpansWithHint(spans any, providerHint string) (*ImportResult, error) {
+	return detectAndImportSpans(spans, providerHint)
+}
+
+func detectAndImportSpans(spans any, providerHint string) (*ImportResult, error) {
+	raw, warnings, err := extractRawSpanMessages(spans)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := spanProviderCandidates()
+	if providerHint != "" {
+		hinted := candidates[:0:0]
+		for _, c := range candidates {
+			if c.name == providerHint {
+				hinted = append(hinted, c)
+			}
+		}
+		if len(hinted) == 0 {
+			return nil, &ConversionError{Message: "unknown provider hint " + strconv.Quote(providerHint)}
+		}
+		candidates = hinted
+	}
+
+	var (
+		bestName     string
+		bestMessages []map[string]any
+		bestScore    = -1.0
+		ties         []string
+	)
+	for _, candidate := range candidates {
+		parsed, parseErr := candidate.parse(raw)
+		if parseErr != nil {
+			continue
+		}
+		score := scoreParsedMessages(parsed)
+		switch {
+		case score > bestScore:
+			bestName, bestMessages, bestScore = candidate.name, parsed, score
+			ties = []string{candidate.name}
+		case score == bestScore:
+			ties = append(ties, candidate.name)
+		}
+	}
+
+	if bestScore < 0 {
+		return nil, &ConversionError{Message: "no provider parser could interpret the given spans"}
+	}
+	if len(ties) > 1 {
+		warnings = append(warnings, "provider detection tied between "+strings.Join(ties, ", ")+"; picked "+bestName)
+	}
+
+	messages, err := mapsToMessages(bestMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportResult{
+		Messages:         messages,
+		DetectedProvider: bestName,
+		ConfidenceScore:  bestScore,
+		Warnings:         warnings,
+	}, nil
+}
+
+// scoreParsedMessages scores a parse by the fraction of messages that came out with a
+// recognized role and a non-nil content field, i.e. fields consumed vs. discarded.
+func scoreParsedMessages(parsed []map[string]any) float64 {
+	if len(parsed) == 0 {
+		return 0
+	}
+	validRoles := map[string]bool{"user": true, "assistant": true, "system": true, "developer": true, "tool": true}
+
+	var recognized int
+	for _, msg := range parsed {
+		role, _ := msg["role"].(string)
+		if validRoles[role] && msg["content"] != nil {
+			recognized++
+		}
+	}
+	return float64(recognized) / float64(len(parsed))
+}
+
+// extractRawSpanMessages normalizes a list of trace spans into a flat list of
+// provider-shaped message maps ready to be handed to a candidate parser, recognizing
+// both Braintrust-style spans and OpenTelemetry gen-ai attribute spans.
+func extractRawSpanMessages(spans any) ([]map[string]any, []string, error) {
+	data, err := json.Marshal(spans)
+	if err != nil {
+		return nil, nil, &ConversionError{Message: "failed to marshal input: " + err.Error()}
+	}
+
+	var spanList []map[string]any
+	if unmarshalErr := json.Unmarshal(data, &spanList); unmarshalErr != nil {
+		return nil, nil, &ConversionError{Message: "Failed to parse input JSON: " + unmarshalErr.Error()}
+	}
+
+	var messages []map[string]any
+	var warnings []string
+	for _, span := range spanList {
+		if attrs, ok := span["attributes"].(map[string]any); ok {
+			if otelMsgs, found := otelMessagesFromAttributes(attrs); found {
+				messages = append(messages, otelMsgs...)
+				continue
+			}
+		}
+
+		foundField := false
+		for _, field := range []string{"input", "output"} {
+			msgs, ok := span[field].([]any)
+			if !ok {
+				continue
+			}
+			foundField = true
+			for _, m := range msgs {
+				if msg, ok := m.(map[string]any); ok {
+					messages = append(messages, msg)
+				}
+			}
+		}
+		if !foundField {
+			warnings = append(warnings, "span had neither gen_ai attributes nor input/output message arrays; skipped")
+		}
+	}
+
+	return messages, warnings, nil
+}
+
+// otelMessagesFromAttributes reconstructs chat messages from OpenTelemetry gen-ai span
+// attributes (`gen_ai.prompt.N.role`, `gen_ai.prompt.N.content`,
+// `gen_ai.completion.N.role`, `gen_ai.completion.N.content`), in prompt-then-completion,
+// index order.
+func otelMessagesFromAttributes(attrs map[string]any) ([]map[string]any, bool) {
+	prompts := map[int]map[string]any{}
+	completions := map[int]map[string]any{}
+
+	for key, value := range attrs {
+		bucket, rest, ok := otelAttributeBucket(key)
+		if !ok {
+			continue
+		}
+		dot := strings.IndexByte(rest, '.')
+		if dot < 0 {
+			continue
+		}
+		idx, err := strconv.Atoi(rest[:dot])
+		if err != nil {
+			continue
+		}
+		field := rest[dot+1:]
+
+		target := prompts
+		if bucket == "completion" {
+			target = completions
+		}
+		if target[idx] == nil {
+			target[idx] = map[string]any{}
+		}
+		target[idx][field] = value
+	}
+
+	if len(prompts) == 0 && len(completions) == 0 {
+		return nil, false
+	}
+
+	messages := append(otelMessagesInOrder(prompts), otelMessagesInOrder(completions)...)
+	return messages, true
+}
+
+// otelAttributeBucket reports whether key is a `gen_ai.prompt.*` or
+// `gen_ai.completion.*` attribute, returning which bucket it belongs to and the
+// remaining "N.field" suffix.
+func otelAttributeBucket(key string) (bucket, rest string, ok bool) {
+	switch {
+	case strings.HasPrefix(key, "gen_ai.prompt."):
+		return "prompt", strings.TrimPrefix(key, "gen_ai.prompt."), true
+	case strings.HasPrefix(key, "gen_ai.completion."):
+		return "completion", strings.TrimPrefix(key, "gen_ai.completion."), true
+	default:
+		return "", "", false
+	}
+}
+
+func otelMessagesInOrder(byIndex map[int]map[string]any) []map[string]any {
+	indices := make([]int, 0, len(byIndex))
+	for idx := range byIndex {
+		indices = append(indices, idx)
+	}
+	sort.Ints(indices)
+
+	out := make([]map[string]any, 0, len(indices))
+	for _, idx := range indices {
+		fields := byIndex[idx]
+		role, _ := fields["role"].(string)
+		if role == "" {
+			role = "assistant"
+		}
+		out = append(out, map[string]any{"role": role, "content": fields["content"]})
+	}
+	return out
+}
@@ -0,0 +1,17 @@
+//go:build cgo
+
+package lingua
+
+// spanProviderCandidates lists the provider parsers span detection tries, in order: on a
+// tied score the earlier candidate wins and the tie is recorded in Warnings. Gemini and Bedrock conversion
+// require the Rust FFI, so they're only available on CGo builds — see
+// span_import_providers_purego.go for the fallback list.
+func spanProviderCandidates() []spanProviderCandidate {
+	return []spanProviderCandidate{
+		{name: "chat_completions", parse: ChatCompletionsMessagesToLingua},
+		{name: "responses", parse: ResponsesMessagesToLingua},
+		{name: "anthropic", parse: AnthropicMessagesToLingua},
+		{name: "gemini", parse: GeminiMessagesToLingua},
+		{name: "bedrock", parse: BedrockConverseMessagesToLingua},
+	}
+}
@@ -0,0 +1,14 @@
+//go:build !cgo
+
+package lingua
+
+// spanProviderCandidates lists the provider parsers span detection tries. Gemini and
+// Bedrock conversion require the Rust FFI and aren't available on pure-Go builds — see
+// span_import_providers_cgo.go for the full list.
+func spanProviderCandidates() []spanProviderCandidate {
+	return []spanProviderCandidate{
+		{name: "chat_completions", parse: ChatCompletionsMessagesToLingua},
+		{name: "responses", parse: ResponsesMessagesToLingua},
+		{name: "anthropic", parse: AnthropicMessagesToLingua},
+	}
+}
@@ -0,0 +1,56 @@
+package lingua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportMessagesFromSpansDetectsOTelGenAISpans(t *testing.T) {
+	spans := []map[string]any{
+		{
+			"attributes": map[string]any{
+				"gen_ai.request.model":        "gpt-4o",
+				"gen_ai.prompt.0.role":        "user",
+				"gen_ai.prompt.0.content":     "What's the weather?",
+				"gen_ai.completion.0.role":    "assistant",
+				"gen_ai.completion.0.content": "It's sunny.",
+			},
+		},
+	}
+
+	result, err := ImportMessagesFromSpans(spans)
+	require.NoError(t, err)
+	require.Len(t, result.Messages, 2)
+}
+
+func TestImportMessagesFromSpansWithHintSkipsDetection(t *testing.T) {
+	spans := []map[string]any{
+		{"input": []map[string]any{{"role": "user", "content": "Hello"}}},
+	}
+
+	result, err := ImportMessagesFromSpansWithHint(spans, "anthropic")
+	require.NoError(t, err)
+	require.Equal(t, "anthropic", result.DetectedProvider)
+	require.Len(t, result.Messages, 1)
+}
+
+func TestImportMessagesFromSpansWithHintRejectsUnknownProvider(t *testing.T) {
+	spans := []map[string]any{
+		{"input": []map[string]any{{"role": "user", "content": "Hello"}}},
+	}
+
+	_, err := ImportMessagesFromSpansWithHint(spans, "not-a-provider")
+	require.Error(t, err)
+}
+
+func TestImportMessagesFromSpansWarnsOnUnrecognizedSpan(t *testing.T) {
+	spans := []map[string]any{
+		{"trace_id": "abc123"},
+	}
+
+	result, err := ImportMessagesFromSpans(spans)
+	require.NoError(t, err)
+	require.Empty(t, result.Messages)
+	require.NotEmpty(t, result.Warnings)
+}
@@ -0,0 +1,317 @@
+package lingua
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Lingua typed stream event kinds. A decoder emits a sequence of these regardless of
+// which provider's wire format it consumed, mirroring how Message/ContentPart offer a
+// typed alternative to the map[string]any message API.
+const (
+	LinguaEventMessageStart     = "message_start"
+	LinguaEventContentPartStart = "content_part_start"
+	LinguaEventContentPartDelta = "content_part_delta"
+	LinguaEventContentPartStop  = "content_part_stop"
+	LinguaEventMessageStop      = "message_stop"
+	LinguaEventUsageUpdate      = "usage_update"
+)
+
+// StreamUsage is token usage reported partway through or at the end of a stream.
+type StreamUsage struct {
+	InputTokens  int `json:"input_tokens,omitempty"`
+	OutputTokens int `json:"output_tokens,omitempty"`
+}
+
+// LinguaStreamEvent is one typed, provider-independent event in a normalized Lingua
+// stream, offered as an alternative to the map[string]any events produced directly by
+// ChatCompletionsStreamDecoder/AnthropicStreamDecoder/ResponsesStreamDecoder. Only the
+// fields relevant to Type are populated; see the LinguaEvent* constants.
+type LinguaStreamEvent struct {
+	Type  string
+	Index int
+
+	// MessageStart. Message is nil for providers (e.g. Chat Completions) whose wire
+	// format has no initial message envelope to decode.
+	Message *Message
+
+	// ContentPartStart: the part being opened, with only the fields known at start time
+	// (e.g. a tool call's ID/Name) populated. On ContentPartDelta, Part is an empty
+	// TextPart or ToolCallPart marking whether the delta is text or tool-call arguments,
+	// so callers can tell them apart without tracking state themselves. It is nil on
+	// ContentPartStop.
+	Part ContentPart
+
+	// ContentPartDelta
+	TextDelta   string // incremental text fragment, set when Part is a TextPart
+	PartialJSON string // tool-call argument JSON accumulated so far, set when Part is a ToolCallPart
+	DeltaJSON   string // the fragment this delta adds to PartialJSON
+
+	// UsageUpdate
+	Usage *StreamUsage
+}
+
+// ChatCompletionsStreamToLingua converts a sequence of OpenAI Chat Completions
+// `chat.completion.chunk` payloads, such as TestSnapshot.StreamingResponse, into
+// normalized Lingua stream events.
+func ChatCompletionsStreamToLingua(chunks []map[string]any) ([]LinguaStreamEvent, error) {
+	return decodeTypedStream(NewChatCompletionsStreamDecoder().DecodeChunks(chunks)), nil
+}
+
+// LinguaToChatCompletionsStream converts normalized Lingua stream events back into Chat
+// Completions `chat.completion.chunk` payloads.
+func LinguaToChatCompletionsStream(events []LinguaStreamEvent) ([]map[string]any, error) {
+	return encodeTypedStream("chat-completions", events)
+}
+
+// AnthropicStreamToLingua converts a sequence of Anthropic Messages API typed SSE event
+// payloads into normalized Lingua stream events.
+func AnthropicStreamToLingua(chunks []map[string]any) ([]LinguaStreamEvent, error) {
+	return decodeTypedStream(NewAnthropicStreamDecoder().DecodeChunks(chunks)), nil
+}
+
+// LinguaToAnthropicStream converts normalized Lingua stream events back into Anthropic
+// Messages API typed SSE event payloads.
+func LinguaToAnthropicStream(events []LinguaStreamEvent) ([]map[string]any, error) {
+	return encodeTypedStream("anthropic", events)
+}
+
+// ResponsesStreamToLingua converts a sequence of OpenAI Responses API typed SSE event
+// payloads into normalized Lingua stream events.
+func ResponsesStreamToLingua(chunks []map[string]any) ([]LinguaStreamEvent, error) {
+	return decodeTypedStream(NewResponsesStreamDecoder().DecodeChunks(chunks)), nil
+}
+
+// LinguaToResponsesStream converts normalized Lingua stream events back into Responses
+// API typed SSE event payloads.
+func LinguaToResponsesStream(events []LinguaStreamEvent) ([]map[string]any, error) {
+	return encodeTypedStream("responses", events)
+}
+
+// decodeTypedStream converts the low-level map-based stream events produced by the
+// *StreamDecoder types into their typed LinguaStreamEvent form, dropping any event with
+// no typed form: an unknown event type, or a block start whose block type has no
+// ContentPart variant (e.g. an audio block).
+func decodeTypedStream(rawEvents []map[string]any) []LinguaStreamEvent {
+	typed := make([]LinguaStreamEvent, 0, len(rawEvents))
+	for _, raw := range rawEvents {
+		event, ok := linguaStreamEventFromMap(raw)
+		if !ok {
+			continue
+		}
+		typed = append(typed, event)
+	}
+	return typed
+}
+
+// encodeTypedStream re-serializes typed Lingua stream events into target's provider
+// chunk shape, reusing the same per-provider encode functions as the SSE encoder.
+func encodeTypedStream(target string, events []LinguaStreamEvent) ([]map[string]any, error) {
+	var encode func(map[string]any) map[string]any
+	switch target {
+	case "chat-completions":
+		encode = encodeChatCompletionsEvent
+	case "anthropic":
+		encode = encodeAnthropicEvent
+	case "responses":
+		encode = encodeResponsesEvent
+	default:
+		return nil, fmt.Errorf("lingua: unsupported stream target %q", target)
+	}
+
+	chunks := make([]map[string]any, 0, len(events))
+	for _, event := range events {
+		rawEvent, err := linguaStreamEventToMap(event)
+		if err != nil {
+			return nil, err
+		}
+		if chunk := encode(rawEvent); chunk != nil {
+			chunks = append(chunks, chunk)
+		}
+	}
+	return chunks, nil
+}
+
+func linguaStreamEventFromMap(ev map[string]any) (LinguaStreamEvent, bool) {
+	index := streamEventIndex(ev["index"])
+
+	switch ev["type"] {
+	case StreamEventMessageStart:
+		typed := LinguaStreamEvent{Type: LinguaEventMessageStart}
+		if messageMap, ok := ev["message"].(map[string]any); ok {
+			if msg, err := messageFromMap(messageMap); err == nil {
+				typed.Message = msg
+			}
+		}
+		return typed, true
+	case StreamEventContentBlockStart:
+		block, _ := ev["content_block"].(map[string]any)
+		part, err := contentPartFromBlock(block)
+		if err != nil {
+			return LinguaStreamEvent{}, false
+		}
+		return LinguaStreamEvent{Type: LinguaEventContentPartStart, Index: index, Part: part}, true
+	case StreamEventContentBlockDelta:
+		delta, _ := ev["delta"].(map[string]any)
+		text, _ := delta["text"].(string)
+		return LinguaStreamEvent{Type: LinguaEventContentPartDelta, Index: index, Part: TextPart{}, TextDelta: text}, true
+	case StreamEventToolUseDelta:
+		partial, _ := ev["partial_json"].(string)
+		deltaJSON, _ := ev["delta_json"].(string)
+		return LinguaStreamEvent{
+			Type: LinguaEventContentPartDelta, Index: index, Part: ToolCallPart{},
+			PartialJSON: partial, DeltaJSON: deltaJSON,
+		}, true
+	case StreamEventContentBlockStop:
+		return LinguaStreamEvent{Type: LinguaEventContentPartStop, Index: index}, true
+	case StreamEventMessageStop:
+		return LinguaStreamEvent{Type: LinguaEventMessageStop}, true
+	case StreamEventUsage:
+		usageMap, _ := ev["usage"].(map[string]any)
+		return LinguaStreamEvent{Type: LinguaEventUsageUpdate, Usage: usageFromMap(usageMap)}, true
+	default:
+		return LinguaStreamEvent{}, false
+	}
+}
+
+func linguaStreamEventToMap(ev LinguaStreamEvent) (map[string]any, error) {
+	switch ev.Type {
+	case LinguaEventMessageStart:
+		raw := map[string]any{"type": StreamEventMessageStart}
+		if ev.Message != nil {
+			messageMap, err := messageToMap(*ev.Message)
+			if err != nil {
+				return nil, err
+			}
+			raw["message"] = messageMap
+		}
+		return raw, nil
+	case LinguaEventContentPartStart:
+		block, err := contentPartToMap(ev.Part)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"type": StreamEventContentBlockStart, "index": ev.Index, "content_block": block}, nil
+	case LinguaEventContentPartDelta:
+		if _, isToolCall := ev.Part.(ToolCallPart); isToolCall {
+			return map[string]any{
+				"type": StreamEventToolUseDelta, "index": ev.Index,
+				"partial_json": ev.PartialJSON, "delta_json": ev.DeltaJSON,
+			}, nil
+		}
+		return map[string]any{
+			"type": StreamEventContentBlockDelta, "index": ev.Index,
+			"delta": map[string]any{"type": "text_delta", "text": ev.TextDelta},
+		}, nil
+	case LinguaEventContentPartStop:
+		return map[string]any{"type": StreamEventContentBlockStop, "index": ev.Index}, nil
+	case LinguaEventMessageStop:
+		return map[string]any{"type": StreamEventMessageStop}, nil
+	case LinguaEventUsageUpdate:
+		usage := map[string]any{}
+		if ev.Usage != nil {
+			data, err := json.Marshal(ev.Usage)
+			if err != nil {
+				return nil, err
+			}
+			if err := json.Unmarshal(data, &usage); err != nil {
+				return nil, err
+			}
+		}
+		return map[string]any{"type": StreamEventUsage, "usage": usage}, nil
+	default:
+		return nil, fmt.Errorf("lingua: unknown stream event type %q", ev.Type)
+	}
+}
+
+func streamEventIndex(v any) int {
+	switch idx := v.(type) {
+	case int:
+		return idx
+	case float64:
+		return int(idx)
+	default:
+		return 0
+	}
+}
+
+func contentPartFromBlock(block map[string]any) (ContentPart, error) {
+	// A Responses `message` output item opens a text part whose text arrives through
+	// later response.output_text.delta events.
+	if block["type"] == "message" {
+		return TextPart{}, nil
+	}
+	block = normalizeResponsesFunctionCallBlock(block)
+	data, err := json.Marshal(block)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalContentPart(data)
+}
+
+// normalizeResponsesFunctionCallBlock remaps a Responses API `response.output_item.added`
+// item for a function call (type:"function_call", keyed by "call_id") onto the tool_use
+// content block shape unmarshalContentPart expects, since Responses uses a different
+// type discriminator and ID field than the Anthropic/Lingua tool_use block it otherwise
+// matches.
+func normalizeResponsesFunctionCallBlock(block map[string]any) map[string]any {
+	if block["type"] != "function_call" {
+		return block
+	}
+	id, _ := block["call_id"].(string)
+	if id == "" {
+		id, _ = block["id"].(string)
+	}
+	normalized := map[string]any{"type": ContentBlockToolUse, "id": id, "name": block["name"]}
+	if input, ok := block["input"]; ok {
+		normalized["input"] = input
+	}
+	return normalized
+}
+
+func messageFromMap(m map[string]any) (*Message, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var msg Message
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+func messageToMap(m Message) (map[string]any, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func usageFromMap(m map[string]any) *StreamUsage {
+	if m == nil {
+		return nil
+	}
+
+	usage := &StreamUsage{}
+	if v, ok := m["input_tokens"].(float64); ok {
+		usage.InputTokens = int(v)
+	}
+	if v, ok := m["output_tokens"].(float64); ok {
+		usage.OutputTokens = int(v)
+	}
+	// Chat Completions/Responses report usage as prompt_tokens/completion_tokens rather
+	// than Anthropic's input_tokens/output_tokens.
+	if v, ok := m["prompt_tokens"].(float64); ok {
+		usage.InputTokens = int(v)
+	}
+	if v, ok := m["completion_tokens"].(float64); ok {
+		usage.OutputTokens = int(v)
+	}
+	return usage
+}
@@ -0,0 +1,104 @@
+package lingua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatCompletionsStreamToLingua(t *testing.T) {
+	chunks := []map[string]any{
+		{"choices": []any{map[string]any{"delta": map[string]any{"content": "Hello"}}}},
+		{"choices": []any{map[string]any{"delta": map[string]any{}, "finish_reason": "stop"}}},
+		{"usage": map[string]any{"prompt_tokens": float64(5), "completion_tokens": float64(2)}},
+	}
+
+	events, err := ChatCompletionsStreamToLingua(chunks)
+	require.NoError(t, err)
+
+	var text string
+	var sawStart, sawStop bool
+	var usage *StreamUsage
+	for _, event := range events {
+		switch event.Type {
+		case LinguaEventMessageStart:
+			sawStart = true
+		case LinguaEventMessageStop:
+			sawStop = true
+		case LinguaEventContentPartDelta:
+			text += event.TextDelta
+		case LinguaEventUsageUpdate:
+			usage = event.Usage
+		}
+	}
+
+	require.True(t, sawStart)
+	require.True(t, sawStop)
+	require.Equal(t, "Hello", text)
+	require.NotNil(t, usage)
+	require.Equal(t, 5, usage.InputTokens)
+	require.Equal(t, 2, usage.OutputTokens)
+}
+
+func TestAnthropicStreamToLinguaToolUse(t *testing.T) {
+	chunks := []map[string]any{
+		{"type": "content_block_start", "index": float64(0), "content_block": map[string]any{"type": "tool_use", "id": "toolu_1", "name": "get_weather"}},
+		{"type": "content_block_delta", "index": float64(0), "delta": map[string]any{"type": "input_json_delta", "partial_json": `{"location":`}},
+		{"type": "content_block_delta", "index": float64(0), "delta": map[string]any{"type": "input_json_delta", "partial_json": `"SF"}`}},
+		{"type": "content_block_stop", "index": float64(0)},
+	}
+
+	events, err := AnthropicStreamToLingua(chunks)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+
+	start := events[0]
+	require.Equal(t, LinguaEventContentPartStart, start.Type)
+	toolCall, ok := start.Part.(ToolCallPart)
+	require.True(t, ok)
+	require.Equal(t, "toolu_1", toolCall.ID)
+	require.Equal(t, "get_weather", toolCall.Name)
+
+	require.Equal(t, `{"location":"SF"}`, events[2].PartialJSON)
+	require.Equal(t, LinguaEventContentPartStop, events[3].Type)
+}
+
+func TestLinguaToResponsesStream(t *testing.T) {
+	events := []LinguaStreamEvent{
+		{Type: LinguaEventContentPartStart, Index: 0, Part: TextPart{}},
+		{Type: LinguaEventContentPartDelta, Index: 0, Part: TextPart{}, TextDelta: "Hi"},
+		{Type: LinguaEventContentPartStop, Index: 0},
+		{Type: LinguaEventMessageStop},
+	}
+
+	chunks, err := LinguaToResponsesStream(events)
+	require.NoError(t, err)
+	require.Len(t, chunks, 4)
+	require.Equal(t, "response.output_item.added", chunks[0]["type"])
+	require.Equal(t, "response.output_text.delta", chunks[1]["type"])
+	require.Equal(t, "Hi", chunks[1]["delta"])
+	require.Equal(t, "response.completed", chunks[3]["type"])
+}
+
+func TestResponsesStreamToLinguaToolCall(t *testing.T) {
+	chunks := []map[string]any{
+		{"type": "response.output_item.added", "output_index": float64(0), "item": map[string]any{"type": "function_call", "call_id": "call_1", "name": "get_weather"}},
+		{"type": "response.function_call_arguments.delta", "output_index": float64(0), "delta": `{"location":"SF"}`},
+		{"type": "response.output_item.done", "output_index": float64(0)},
+		{"type": "response.completed", "response": map[string]any{"usage": map[string]any{"input_tokens": float64(3), "output_tokens": float64(1)}}},
+	}
+
+	events, err := ResponsesStreamToLingua(chunks)
+	require.NoError(t, err)
+	require.Len(t, events, 5)
+	require.Equal(t, LinguaEventContentPartStart, events[0].Type)
+	toolCall, ok := events[0].Part.(ToolCallPart)
+	require.True(t, ok, "function_call item should decode as a ToolCallPart")
+	require.Equal(t, "call_1", toolCall.ID)
+	require.Equal(t, "get_weather", toolCall.Name)
+	require.Equal(t, LinguaEventContentPartDelta, events[1].Type)
+	require.Equal(t, `{"location":"SF"}`, events[1].PartialJSON)
+	require.Equal(t, LinguaEventUsageUpdate, events[3].Type)
+	require.Equal(t, 3, events[3].Usage.InputTokens)
+	require.Equal(t, LinguaEventMessageStop, events[4].Type)
+}
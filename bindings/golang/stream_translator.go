@@ -0,0 +1,125 @@
+//go:build cgo
+
+package lingua
+
+/*
+#include <stdlib.h>
+
+// Forward declarations of the incremental stream-translation Rust FFI functions.
+extern void* lingua_stream_translator_new(const char* src_format, const char* dst_format, char** error_out);
+extern char* lingua_stream_translator_write(void* handle, const char* chunk, char** error_out);
+extern char* lingua_stream_translator_flush(void* handle, char** error_out);
+extern void lingua_stream_translator_free(void* handle);
+
+// lingua_free_string releases a string allocated by any of the functions above. Each
+// cgo preamble is scoped to its own file, so this must be redeclared here rather than
+// reused from lingua.go's.
+extern void lingua_free_string(char* s);
+*/
+import "C"
+import (
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+// TranslatedSSEEvent is one decoded Server-Sent Event frame produced by a
+// StreamTranslator: an optional `event:` name and the parsed `data:` JSON payload.
+type TranslatedSSEEvent struct {
+	Event string
+	Data  map[string]any
+}
+
+// StreamTranslator incrementally translates SSE chunks from one provider's streaming
+// format to another (e.g. OpenAI `chat.completion.chunk` -> Anthropic
+// `content_block_delta`/`message_delta`), tracking partial state — such as
+// accumulating tool-call argument fragments — across Write calls.
+type StreamTranslator struct {
+	handle unsafe.Pointer
+}
+
+// NewStreamTranslator creates a translator from srcFormat to dstFormat, where each is
+// one of "chat-completions", "anthropic", or "responses".
+func NewStreamTranslator(srcFormat, dstFormat string) (*StreamTranslator, error) {
+	cSrc := C.CString(srcFormat)
+	defer C.free(unsafe.Pointer(cSrc))
+	cDst := C.CString(dstFormat)
+	defer C.free(unsafe.Pointer(cDst))
+
+	var cError *C.char
+	handle := C.lingua_stream_translator_new(cSrc, cDst, &cError)
+	if cError != nil {
+		errMsg := C.GoString(cError)
+		C.lingua_free_string(cError)
+		return nil, fmt.Errorf("lingua: failed to create stream translator: %s", errMsg)
+	}
+	if handle == nil {
+		return nil, fmt.Errorf("lingua: failed to create stream translator from %q to %q", srcFormat, dstFormat)
+	}
+
+	t := &StreamTranslator{handle: handle}
+	runtime.SetFinalizer(t, (*StreamTranslator).Close)
+	return t, nil
+}
+
+// Write feeds one raw SSE chunk from the source format and returns the translated SSE
+// events ready to forward to a destination-format consumer.
+func (t *StreamTranslator) Write(chunk []byte) ([]TranslatedSSEEvent, error) {
+	if t.handle == nil {
+		return nil, fmt.Errorf("lingua: stream translator is closed")
+	}
+
+	cChunk := C.CString(string(chunk))
+	defer C.free(unsafe.Pointer(cChunk))
+
+	var cError *C.char
+	cResult := C.lingua_stream_translator_write(t.handle, cChunk, &cError)
+	return t.decodeResult(cResult, cError)
+}
+
+// Flush finalizes the translation, returning any remaining buffered events (for
+// example a closing `message_stop`).
+func (t *StreamTranslator) Flush() ([]TranslatedSSEEvent, error) {
+	if t.handle == nil {
+		return nil, fmt.Errorf("lingua: stream translator is closed")
+	}
+
+	var cError *C.char
+	cResult := C.lingua_stream_translator_flush(t.handle, &cError)
+	return t.decodeResult(cResult, cError)
+}
+
+// decodeResult handles the (result, error) pair every translator FFI call returns:
+// surface cError as a Go error, else JSON-decode cResult into the events it carries.
+func (t *StreamTranslator) decodeResult(cResult, cError *C.char) ([]TranslatedSSEEvent, error) {
+	if cError != nil {
+		errMsg := C.GoString(cError)
+		C.lingua_free_string(cError)
+		return nil, fmt.Errorf("lingua: stream translation failed: %s", errMsg)
+	}
+	if cResult == nil {
+		return nil, nil
+	}
+
+	resultJSON := C.GoString(cResult)
+	C.lingua_free_string(cResult)
+
+	var events []TranslatedSSEEvent
+	if err := json.Unmarshal([]byte(resultJSON), &events); err != nil {
+		return nil, fmt.Errorf("lingua: failed to unmarshal stream translation result: %w", err)
+	}
+	return events, nil
+}
+
+// Close releases the translator's underlying Rust state. It is called automatically by
+// the garbage collector, but should be called explicitly once a stream finishes to
+// release the backing memory promptly.
+func (t *StreamTranslator) Close() {
+	if t.handle == nil {
+		return
+	}
+	C.lingua_stream_translator_free(t.handle)
+	t.handle = nil
+	runtime.SetFinalizer(t, nil)
+}
@@ -0,0 +1,54 @@
+//go:build cgo
+
+package lingua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamTranslatorChatCompletionsToAnthropic(t *testing.T) {
+	translator, err := NewStreamTranslator("chat-completions", "anthropic")
+	require.NoError(t, err)
+	defer translator.Close()
+
+	events, err := translator.Write([]byte(`data: {"choices":[{"delta":{"content":"Hello"}}]}` + "\n\n"))
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+
+	final, err := translator.Flush()
+	require.NoError(t, err)
+	_ = final
+}
+
+func TestStreamTranslatorToolCallArguments(t *testing.T) {
+	translator, err := NewStreamTranslator("chat-completions", "anthropic")
+	require.NoError(t, err)
+	defer translator.Close()
+
+	_, err = translator.Write([]byte(
+		`data: {"choices":[{"delta":{"tool_calls":[{"index":0,"function":{"name":"get_weather","arguments":"{\"location\":"}}]}}]}` + "\n\n",
+	))
+	require.NoError(t, err)
+
+	_, err = translator.Write([]byte(
+		`data: {"choices":[{"delta":{"tool_calls":[{"index":0,"function":{"arguments":"\"SF\"}"}}]}}]}` + "\n\n",
+	))
+	require.NoError(t, err)
+}
+
+func TestStreamTranslatorInvalidFormat(t *testing.T) {
+	_, err := NewStreamTranslator("chat-completions", "not-a-real-format")
+	require.Error(t, err)
+}
+
+func TestStreamTranslatorWriteAfterClose(t *testing.T) {
+	translator, err := NewStreamTranslator("chat-completions", "anthropic")
+	require.NoError(t, err)
+
+	translator.Close()
+
+	_, err = translator.Write([]byte(`data: {}` + "\n\n"))
+	require.Error(t, err)
+}
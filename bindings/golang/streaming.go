@@ -0,0 +1,594 @@
+package lingua
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Lingua stream event types. A decoder emits a sequence of these events for a single
+// streamed message, mirroring Anthropic's typed SSE event names so either provider's
+// stream can be re-expressed without buffering the whole response.
+const (
+	StreamEventMessageStart      = "message_start"
+	StreamEventContentBlockStart = "content_block_start"
+	StreamEventContentBlockDelta = "content_block_delta"
+	StreamEventContentBlockStop  = "content_block_stop"
+	StreamEventMessageStop       = "message_stop"
+	StreamEventToolUseDelta      = "tool_use_delta"
+	StreamEventUsage             = "usage"
+)
+
+// sseDataLines extracts the `data: ...` payloads from a raw SSE chunk, skipping
+// blank lines, `event:` lines, and the terminal `[DONE]` sentinel.
+func sseDataLines(chunk []byte) []string {
+	var lines []string
+	for _, evt := range ParseSSEStream(chunk) {
+		lines = append(lines, evt.Data)
+	}
+	return lines
+}
+
+// SSEEvent is one parsed Server-Sent Events frame: an optional event name (from an
+// `event:` line) and its `data:` payload. Multiple `data:` lines within the same frame
+// are joined with "\n", per the SSE spec.
+type SSEEvent struct {
+	Event string
+	Data  string
+}
+
+// ParseSSEStream parses a raw SSE byte stream (`event: ...`, `data: {...}`,
+// blank-line-delimited frames, as OpenAI/Anthropic transmit streaming responses) into
+// its constituent frames. The terminal `data: [DONE]` sentinel is dropped, and `id:`/
+// `retry:`/comment lines are ignored since no provider or decoder here uses them.
+func ParseSSEStream(raw []byte) []SSEEvent {
+	var (
+		events    []SSEEvent
+		eventName string
+		dataLines []string
+	)
+
+	flush := func() {
+		if len(dataLines) == 0 {
+			eventName = ""
+			return
+		}
+		if data := strings.Join(dataLines, "\n"); data != "[DONE]" {
+			events = append(events, SSEEvent{Event: eventName, Data: data})
+		}
+		eventName = ""
+		dataLines = nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "event:"):
+			eventName = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			dataLines = append(dataLines, strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+		}
+	}
+	flush()
+
+	return events
+}
+
+// EmitSSEStream serializes events back into SSE wire format, blank-line delimited,
+// with a terminal `data: [DONE]` sentinel appended to match OpenAI/Anthropic streams.
+func EmitSSEStream(events []SSEEvent) []byte {
+	var buf bytes.Buffer
+	for _, evt := range events {
+		if evt.Event != "" {
+			fmt.Fprintf(&buf, "event: %s\n", evt.Event)
+		}
+		fmt.Fprintf(&buf, "data: %s\n\n", evt.Data)
+	}
+	buf.WriteString("data: [DONE]\n\n")
+	return buf.Bytes()
+}
+
+// chatCompletionsToolIndexOffset is added to a Chat Completions tool_calls[].index when
+// decoding, reserving Lingua content-block index 0 for the implicit text block Chat
+// Completions deltas carry alongside tool calls. It must be subtracted back off when
+// re-encoding to Chat Completions' own zero-based tool_calls[].index.
+const chatCompletionsToolIndexOffset = 1
+
+// toolArgAccumulator tracks partial JSON argument fragments per content block index
+// so each tool_use_delta a decoder emits carries the full string accumulated so far.
+type toolArgAccumulator struct {
+	fragments map[int]*strings.Builder
+}
+
+func newToolArgAccumulator() *toolArgAccumulator {
+	return &toolArgAccumulator{fragments: make(map[int]*strings.Builder)}
+}
+
+func (a *toolArgAccumulator) append(index int, fragment string) string {
+	b, ok := a.fragments[index]
+	if !ok {
+		b = &strings.Builder{}
+		a.fragments[index] = b
+	}
+	b.WriteString(fragment)
+	return b.String()
+}
+
+// ChatCompletionsStreamDecoder converts OpenAI Chat Completions `chat.completion.chunk`
+// SSE chunks into normalized Lingua stream events, accumulating `tool_calls[].function.arguments`
+// fragments per tool-call index.
+type ChatCompletionsStreamDecoder struct {
+	toolArgs    *toolArgAccumulator
+	started     bool
+	blockOpened map[int]bool
+}
+
+// NewChatCompletionsStreamDecoder creates a decoder for Chat Completions SSE streams.
+func NewChatCompletionsStreamDecoder() *ChatCompletionsStreamDecoder {
+	return &ChatCompletionsStreamDecoder{
+		toolArgs:    newToolArgAccumulator(),
+		blockOpened: make(map[int]bool),
+	}
+}
+
+// Decode parses one SSE chunk (which may contain multiple `data:` lines) and returns
+// the normalized Lingua stream events it produces.
+func (d *ChatCompletionsStreamDecoder) Decode(chunk []byte) ([]map[string]any, error) {
+	var events []map[string]any
+
+	for _, data := range sseDataLines(chunk) {
+		var payload map[string]any
+		if err := json.Unmarshal([]byte(data), &payload); err != nil {
+			return nil, fmt.Errorf("lingua: failed to parse chat completions chunk: %w", err)
+		}
+		events = append(events, d.DecodeChunk(payload)...)
+	}
+
+	return events, nil
+}
+
+// DecodeChunk decodes one already-parsed Chat Completions `chat.completion.chunk`
+// payload, for callers (such as DecodeChunks) that already have the chunk as a
+// map[string]any rather than raw SSE bytes.
+func (d *ChatCompletionsStreamDecoder) DecodeChunk(payload map[string]any) []map[string]any {
+	var events []map[string]any
+
+	if !d.started {
+		d.started = true
+		events = append(events, map[string]any{"type": StreamEventMessageStart})
+	}
+
+	choices, _ := payload["choices"].([]any)
+	for _, c := range choices {
+		choice, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		delta, _ := choice["delta"].(map[string]any)
+		events = append(events, d.decodeDelta(delta)...)
+
+		if choice["finish_reason"] != nil {
+			events = append(events, map[string]any{"type": StreamEventMessageStop})
+		}
+	}
+
+	if usage, ok := payload["usage"].(map[string]any); ok {
+		events = append(events, map[string]any{"type": StreamEventUsage, "usage": usage})
+	}
+
+	return events
+}
+
+// DecodeChunks decodes a sequence of already-parsed Chat Completions chunks, as
+// collected in a snapshot's StreamingResponse, into normalized Lingua stream events.
+func (d *ChatCompletionsStreamDecoder) DecodeChunks(chunks []map[string]any) []map[string]any {
+	var events []map[string]any
+	for _, chunk := range chunks {
+		events = append(events, d.DecodeChunk(chunk)...)
+	}
+	return events
+}
+
+func (d *ChatCompletionsStreamDecoder) decodeDelta(delta map[string]any) []map[string]any {
+	var events []map[string]any
+
+	if text, ok := delta["content"].(string); ok && text != "" {
+		if !d.blockOpened[0] {
+			d.blockOpened[0] = true
+			events = append(events, map[string]any{"type": StreamEventContentBlockStart, "index": 0, "content_block": map[string]any{"type": "text"}})
+		}
+		events = append(events, map[string]any{"type": StreamEventContentBlockDelta, "index": 0, "delta": map[string]any{"type": "text_delta", "text": text}})
+	}
+
+	toolCalls, _ := delta["tool_calls"].([]any)
+	for _, tc := range toolCalls {
+		toolCall, ok := tc.(map[string]any)
+		if !ok {
+			continue
+		}
+		index := 0
+		if idx, ok := toolCall["index"].(float64); ok {
+			index = int(idx) + chatCompletionsToolIndexOffset
+		}
+
+		if !d.blockOpened[index] {
+			d.blockOpened[index] = true
+			events = append(events, map[string]any{"type": StreamEventContentBlockStart, "index": index, "content_block": map[string]any{"type": ContentBlockToolUse}})
+		}
+
+		function, _ := toolCall["function"].(map[string]any)
+		if function == nil {
+			continue
+		}
+		if args, ok := function["arguments"].(string); ok && args != "" {
+			partial := d.toolArgs.append(index, args)
+			events = append(events, map[string]any{
+				"type":         StreamEventToolUseDelta,
+				"index":        index,
+				"partial_json": partial,
+				"delta_json":   args,
+				"name":         function["name"],
+			})
+		}
+	}
+
+	return events
+}
+
+// AnthropicStreamDecoder converts Anthropic Messages API typed SSE events into
+// normalized Lingua stream events, accumulating `input_json_delta` fragments per
+// content block index; `content_block_stop` is passed through as a stop event.
+type AnthropicStreamDecoder struct {
+	toolArgs *toolArgAccumulator
+}
+
+// NewAnthropicStreamDecoder creates a decoder for Anthropic Messages API SSE streams.
+func NewAnthropicStreamDecoder() *AnthropicStreamDecoder {
+	return &AnthropicStreamDecoder{toolArgs: newToolArgAccumulator()}
+}
+
+// Decode parses one SSE chunk and returns the normalized Lingua stream events it produces.
+func (d *AnthropicStreamDecoder) Decode(chunk []byte) ([]map[string]any, error) {
+	var events []map[string]any
+
+	for _, data := range sseDataLines(chunk) {
+		var payload map[string]any
+		if err := json.Unmarshal([]byte(data), &payload); err != nil {
+			return nil, fmt.Errorf("lingua: failed to parse anthropic event: %w", err)
+		}
+		events = append(events, d.DecodeChunk(payload)...)
+	}
+
+	return events, nil
+}
+
+// DecodeChunk decodes one already-parsed Anthropic Messages API SSE event payload, for
+// callers (such as DecodeChunks) that already have the event as a map[string]any rather
+// than raw SSE bytes.
+func (d *AnthropicStreamDecoder) DecodeChunk(payload map[string]any) []map[string]any {
+	var events []map[string]any
+
+	eventType, _ := payload["type"].(string)
+	switch eventType {
+	case "message_start":
+		events = append(events, map[string]any{"type": StreamEventMessageStart, "message": payload["message"]})
+	case "content_block_start":
+		events = append(events, map[string]any{"type": StreamEventContentBlockStart, "index": payload["index"], "content_block": payload["content_block"]})
+	case "content_block_delta":
+		events = append(events, d.decodeContentBlockDelta(payload)...)
+	case "content_block_stop":
+		events = append(events, map[string]any{"type": StreamEventContentBlockStop, "index": payload["index"]})
+	case "message_delta":
+		if usage, ok := payload["usage"].(map[string]any); ok {
+			events = append(events, map[string]any{"type": StreamEventUsage, "usage": usage})
+		}
+		events = append(events, map[string]any{"type": StreamEventMessageStop, "delta": payload["delta"]})
+	case "message_stop":
+		events = append(events, map[string]any{"type": StreamEventMessageStop, "delta": payload["delta"]})
+	}
+
+	return events
+}
+
+// DecodeChunks decodes a sequence of already-parsed Anthropic SSE event payloads, as
+// collected in a snapshot's StreamingResponse, into normalized Lingua stream events.
+func (d *AnthropicStreamDecoder) DecodeChunks(chunks []map[string]any) []map[string]any {
+	var events []map[string]any
+	for _, chunk := range chunks {
+		events = append(events, d.DecodeChunk(chunk)...)
+	}
+	return events
+}
+
+func (d *AnthropicStreamDecoder) decodeContentBlockDelta(payload map[string]any) []map[string]any {
+	index := 0
+	if idx, ok := payload["index"].(float64); ok {
+		index = int(idx)
+	}
+
+	delta, _ := payload["delta"].(map[string]any)
+	if delta == nil {
+		return nil
+	}
+
+	switch delta["type"] {
+	case "text_delta":
+		return []map[string]any{{"type": StreamEventContentBlockDelta, "index": index, "delta": delta}}
+	case "input_json_delta":
+		fragment, _ := delta["partial_json"].(string)
+		partial := d.toolArgs.append(index, fragment)
+		return []map[string]any{{
+			"type":         StreamEventToolUseDelta,
+			"index":        index,
+			"partial_json": partial,
+			"delta_json":   fragment,
+		}}
+	default:
+		return []map[string]any{{"type": StreamEventContentBlockDelta, "index": index, "delta": delta}}
+	}
+}
+
+// ResponsesStreamDecoder converts OpenAI Responses API typed SSE events
+// (`response.output_item.added`, `response.output_text.delta`,
+// `response.function_call_arguments.delta`, ...) into normalized Lingua stream events,
+// accumulating function-call argument fragments per output-item index.
+type ResponsesStreamDecoder struct {
+	toolArgs *toolArgAccumulator
+}
+
+// NewResponsesStreamDecoder creates a decoder for Responses API SSE streams.
+func NewResponsesStreamDecoder() *ResponsesStreamDecoder {
+	return &ResponsesStreamDecoder{toolArgs: newToolArgAccumulator()}
+}
+
+// Decode parses one SSE chunk and returns the normalized Lingua stream events it produces.
+func (d *ResponsesStreamDecoder) Decode(chunk []byte) ([]map[string]any, error) {
+	var events []map[string]any
+
+	for _, data := range sseDataLines(chunk) {
+		var payload map[string]any
+		if err := json.Unmarshal([]byte(data), &payload); err != nil {
+			return nil, fmt.Errorf("lingua: failed to parse responses event: %w", err)
+		}
+		events = append(events, d.DecodeChunk(payload)...)
+	}
+
+	return events, nil
+}
+
+// DecodeChunk decodes one already-parsed Responses API SSE event payload, for callers
+// (such as DecodeChunks) that already have the event as a map[string]any rather than
+// raw SSE bytes.
+func (d *ResponsesStreamDecoder) DecodeChunk(payload map[string]any) []map[string]any {
+	eventType, _ := payload["type"].(string)
+	switch eventType {
+	case "response.created":
+		return []map[string]any{{"type": StreamEventMessageStart, "message": payload["response"]}}
+	case "response.output_item.added":
+		item, _ := payload["item"].(map[string]any)
+		return []map[string]any{{"type": StreamEventContentBlockStart, "index": payload["output_index"], "content_block": item}}
+	case "response.output_text.delta":
+		delta, _ := payload["delta"].(string)
+		return []map[string]any{{
+			"type":  StreamEventContentBlockDelta,
+			"index": payload["output_index"],
+			"delta": map[string]any{"type": "text_delta", "text": delta},
+		}}
+	case "response.function_call_arguments.delta":
+		index := 0
+		if idx, ok := payload["output_index"].(float64); ok {
+			index = int(idx)
+		}
+		fragment, _ := payload["delta"].(string)
+		partial := d.toolArgs.append(index, fragment)
+		return []map[string]any{{
+			"type":         StreamEventToolUseDelta,
+			"index":        index,
+			"partial_json": partial,
+			"delta_json":   fragment,
+		}}
+	case "response.output_item.done":
+		return []map[string]any{{"type": StreamEventContentBlockStop, "index": payload["output_index"]}}
+	case "response.completed":
+		var events []map[string]any
+		if response, ok := payload["response"].(map[string]any); ok {
+			if usage, ok := response["usage"].(map[string]any); ok {
+				events = append(events, map[string]any{"type": StreamEventUsage, "usage": usage})
+			}
+		}
+		return append(events, map[string]any{"type": StreamEventMessageStop})
+	default:
+		return nil
+	}
+}
+
+// DecodeChunks decodes a sequence of already-parsed Responses API SSE event payloads,
+// as collected in a snapshot's StreamingResponse, into normalized Lingua stream events.
+func (d *ResponsesStreamDecoder) DecodeChunks(chunks []map[string]any) []map[string]any {
+	var events []map[string]any
+	for _, chunk := range chunks {
+		events = append(events, d.DecodeChunk(chunk)...)
+	}
+	return events
+}
+
+// LinguaStreamEncoder re-serializes a normalized Lingua stream event sequence back into
+// a target provider's SSE wire format.
+type LinguaStreamEncoder struct {
+	target string
+}
+
+// NewLinguaStreamEncoder creates an encoder targeting "chat-completions", "anthropic",
+// or "responses".
+func NewLinguaStreamEncoder(target string) (*LinguaStreamEncoder, error) {
+	switch target {
+	case "chat-completions", "anthropic", "responses":
+		return &LinguaStreamEncoder{target: target}, nil
+	default:
+		return nil, fmt.Errorf("lingua: unsupported stream encoder target %q", target)
+	}
+}
+
+// Encode serializes one Lingua stream event as an SSE frame (`data: {...}\n\n`) in the
+// encoder's target format.
+func (e *LinguaStreamEncoder) Encode(event map[string]any) ([]byte, error) {
+	var payload map[string]any
+	switch e.target {
+	case "chat-completions":
+		payload = encodeChatCompletionsEvent(event)
+	case "anthropic":
+		payload = encodeAnthropicEvent(event)
+	case "responses":
+		payload = encodeResponsesEvent(event)
+	}
+	if payload == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("lingua: failed to encode stream event: %w", err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("data: ")
+	buf.Write(data)
+	buf.WriteString("\n\n")
+	return buf.Bytes(), nil
+}
+
+// chatCompletionsToolIndexFromEvent reverses chatCompletionsToolIndexOffset so a
+// tool-call index round-trips through Lingua's shared content-block index space back to
+// Chat Completions' own zero-based tool_calls[].index. v is an int when it came straight
+// from ChatCompletionsStreamDecoder, but a float64 when the event was serialized to JSON
+// and back (e.g. queued or cached between decode and encode), so both are handled.
+func chatCompletionsToolIndexFromEvent(v any) any {
+	var idx int
+	switch n := v.(type) {
+	case int:
+		idx = n
+	case float64:
+		idx = int(n)
+	default:
+		return v
+	}
+	if idx -= chatCompletionsToolIndexOffset; idx < 0 {
+		idx = 0
+	}
+	return idx
+}
+
+func encodeChatCompletionsEvent(event map[string]any) map[string]any {
+	switch event["type"] {
+	case StreamEventContentBlockDelta:
+		delta, _ := event["delta"].(map[string]any)
+		if delta == nil || delta["type"] != "text_delta" {
+			return nil
+		}
+		return map[string]any{
+			"choices": []map[string]any{{"delta": map[string]any{"content": delta["text"]}}},
+		}
+	case StreamEventToolUseDelta:
+		return map[string]any{
+			"choices": []map[string]any{{
+				"delta": map[string]any{
+					"tool_calls": []map[string]any{{
+						"index":    chatCompletionsToolIndexFromEvent(event["index"]),
+						"function": map[string]any{"arguments": event["delta_json"]},
+					}},
+				},
+			}},
+		}
+	case StreamEventMessageStop:
+		return map[string]any{"choices": []map[string]any{{"finish_reason": "stop"}}}
+	default:
+		return nil
+	}
+}
+
+func encodeAnthropicEvent(event map[string]any) map[string]any {
+	switch event["type"] {
+	case StreamEventMessageStart:
+		return map[string]any{"type": "message_start", "message": event["message"]}
+	case StreamEventContentBlockStart:
+		return map[string]any{"type": "content_block_start", "index": event["index"], "content_block": event["content_block"]}
+	case StreamEventContentBlockDelta:
+		return map[string]any{"type": "content_block_delta", "index": event["index"], "delta": event["delta"]}
+	case StreamEventToolUseDelta:
+		return map[string]any{
+			"type":  "content_block_delta",
+			"index": event["index"],
+			"delta": map[string]any{"type": "input_json_delta", "partial_json": event["delta_json"]},
+		}
+	case StreamEventContentBlockStop:
+		return map[string]any{"type": "content_block_stop", "index": event["index"]}
+	case StreamEventMessageStop:
+		return map[string]any{"type": "message_stop"}
+	default:
+		return nil
+	}
+}
+
+func encodeResponsesEvent(event map[string]any) map[string]any {
+	switch event["type"] {
+	case StreamEventMessageStart:
+		return map[string]any{"type": "response.created", "response": event["message"]}
+	case StreamEventContentBlockStart:
+		return map[string]any{"type": "response.output_item.added", "output_index": event["index"], "item": event["content_block"]}
+	case StreamEventContentBlockDelta:
+		delta, _ := event["delta"].(map[string]any)
+		if delta == nil || delta["type"] != "text_delta" {
+			return nil
+		}
+		return map[string]any{"type": "response.output_text.delta", "output_index": event["index"], "delta": delta["text"]}
+	case StreamEventToolUseDelta:
+		return map[string]any{"type": "response.function_call_arguments.delta", "output_index": event["index"], "delta": event["delta_json"]}
+	case StreamEventContentBlockStop:
+		return map[string]any{"type": "response.output_item.done", "output_index": event["index"]}
+	case StreamEventMessageStop:
+		return map[string]any{"type": "response.completed"}
+	default:
+		return nil
+	}
+}
+
+// LinguaStreamToChatCompletionsSSE encodes a full sequence of Lingua stream events as
+// concatenated Chat Completions SSE frames.
+func LinguaStreamToChatCompletionsSSE(events []map[string]any) ([]byte, error) {
+	return encodeLinguaStream("chat-completions", events)
+}
+
+// LinguaStreamToAnthropicSSE encodes a full sequence of Lingua stream events as
+// concatenated Anthropic SSE frames.
+func LinguaStreamToAnthropicSSE(events []map[string]any) ([]byte, error) {
+	return encodeLinguaStream("anthropic", events)
+}
+
+// LinguaStreamToResponsesSSE encodes a full sequence of Lingua stream events as
+// concatenated Responses API SSE frames.
+func LinguaStreamToResponsesSSE(events []map[string]any) ([]byte, error) {
+	return encodeLinguaStream("responses", events)
+}
+
+func encodeLinguaStream(target string, events []map[string]any) ([]byte, error) {
+	encoder, err := NewLinguaStreamEncoder(target)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	for _, event := range events {
+		frame, err := encoder.Encode(event)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(frame)
+	}
+	return buf.Bytes(), nil
+}
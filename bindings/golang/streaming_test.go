@@ -0,0 +1,159 @@
+package lingua
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatCompletionsStreamDecoderText(t *testing.T) {
+	decoder := NewChatCompletionsStreamDecoder()
+
+	chunks := []string{
+		`data: {"choices":[{"delta":{"content":"Hello"}}]}`,
+		`data: {"choices":[{"delta":{"content":", world"}}]}`,
+		`data: {"choices":[{"delta":{},"finish_reason":"stop"}]}`,
+		`data: [DONE]`,
+	}
+
+	var events []map[string]any
+	for _, chunk := range chunks {
+		decoded, err := decoder.Decode([]byte(chunk))
+		require.NoError(t, err)
+		events = append(events, decoded...)
+	}
+
+	var text strings.Builder
+	sawStart, sawStop := false, false
+	for _, event := range events {
+		switch event["type"] {
+		case StreamEventMessageStart:
+			sawStart = true
+		case StreamEventMessageStop:
+			sawStop = true
+		case StreamEventContentBlockDelta:
+			delta, _ := event["delta"].(map[string]any)
+			if s, ok := delta["text"].(string); ok {
+				text.WriteString(s)
+			}
+		}
+	}
+
+	require.True(t, sawStart)
+	require.True(t, sawStop)
+	require.Equal(t, "Hello, world", text.String())
+}
+
+func TestAnthropicStreamDecoderToolUse(t *testing.T) {
+	decoder := NewAnthropicStreamDecoder()
+
+	chunks := []string{
+		`data: {"type":"content_block_start","index":0,"content_block":{"type":"tool_use","id":"toolu_1","name":"get_weather"}}`,
+		`data: {"type":"content_block_delta","index":0,"delta":{"type":"input_json_delta","partial_json":"{\"location\":"}}`,
+		`data: {"type":"content_block_delta","index":0,"delta":{"type":"input_json_delta","partial_json":"\"SF\"}"}}`,
+		`data: {"type":"content_block_stop","index":0}`,
+	}
+
+	var partial string
+	for _, chunk := range chunks {
+		events, err := decoder.Decode([]byte(chunk))
+		require.NoError(t, err)
+		for _, event := range events {
+			if event["type"] == StreamEventToolUseDelta {
+				partial = event["partial_json"].(string)
+			}
+		}
+	}
+
+	require.Equal(t, `{"location":"SF"}`, partial)
+}
+
+func TestStreamDecodeReencodeRoundtrip(t *testing.T) {
+	decoder := NewChatCompletionsStreamDecoder()
+
+	chunks := []string{
+		`data: {"choices":[{"delta":{"content":"Hi"}}]}`,
+		`data: {"choices":[{"delta":{},"finish_reason":"stop"}]}`,
+	}
+
+	var events []map[string]any
+	for _, chunk := range chunks {
+		decoded, err := decoder.Decode([]byte(chunk))
+		require.NoError(t, err)
+		events = append(events, decoded...)
+	}
+
+	sse, err := LinguaStreamToChatCompletionsSSE(events)
+	require.NoError(t, err)
+	require.Contains(t, string(sse), `"content":"Hi"`)
+
+	anthropicSSE, err := LinguaStreamToAnthropicSSE(events)
+	require.NoError(t, err)
+	require.Contains(t, string(anthropicSSE), "message_stop")
+}
+
+func TestStreamDecodeReencodeRoundtripPreservesToolCallIndex(t *testing.T) {
+	decoder := NewChatCompletionsStreamDecoder()
+
+	chunks := []string{
+		`data: {"choices":[{"delta":{"tool_calls":[{"index":0,"function":{"name":"get_weather","arguments":"{\"location\":\"SF\"}"}}]}}]}`,
+	}
+
+	var events []map[string]any
+	for _, chunk := range chunks {
+		decoded, err := decoder.Decode([]byte(chunk))
+		require.NoError(t, err)
+		events = append(events, decoded...)
+	}
+
+	sse, err := LinguaStreamToChatCompletionsSSE(events)
+	require.NoError(t, err)
+	require.Contains(t, string(sse), `"index":0`)
+	require.NotContains(t, string(sse), `"index":1`)
+}
+
+func TestEncodeChatCompletionsToolIndexSurvivesJSONRoundTrip(t *testing.T) {
+	// Simulate an event that was serialized to JSON and back (e.g. queued between
+	// decode and encode), so its numeric fields decode as float64 rather than int.
+	raw, err := json.Marshal(map[string]any{
+		"type":       StreamEventToolUseDelta,
+		"index":      1,
+		"delta_json": `{"location":"SF"}`,
+	})
+	require.NoError(t, err)
+
+	var event map[string]any
+	require.NoError(t, json.Unmarshal(raw, &event))
+
+	sse, err := LinguaStreamToChatCompletionsSSE([]map[string]any{event})
+	require.NoError(t, err)
+	require.Contains(t, string(sse), `"index":0`)
+}
+
+func TestParseSSEStreamPreservesEventNames(t *testing.T) {
+	raw := "event: content_block_delta\n" +
+		`data: {"delta":{"text":"Hi"}}` + "\n\n" +
+		"event: message_stop\n" +
+		"data: {}\n\n" +
+		"data: [DONE]\n\n"
+
+	events := ParseSSEStream([]byte(raw))
+	require.Len(t, events, 2)
+	require.Equal(t, "content_block_delta", events[0].Event)
+	require.JSONEq(t, `{"delta":{"text":"Hi"}}`, events[0].Data)
+	require.Equal(t, "message_stop", events[1].Event)
+}
+
+func TestEmitSSEStreamRoundTripsThroughParseSSEStream(t *testing.T) {
+	original := []SSEEvent{
+		{Event: "message_start", Data: `{"type":"message_start"}`},
+		{Event: "content_block_delta", Data: `{"delta":{"text":"Hi"}}`},
+	}
+
+	raw := EmitSSEStream(original)
+	parsed := ParseSSEStream(raw)
+
+	require.Equal(t, original, parsed)
+}
@@ -0,0 +1,35 @@
+package lingua
+
+// Lingua represents tool calls and their results as canonical content blocks so
+// every provider's distinct wire format (OpenAI's `tool_calls` array plus
+// `role:"tool"` messages, Anthropic's `tool_use`/`tool_result` blocks, and so on)
+// converts through a single shape.
+const (
+	// ContentBlockToolUse identifies a tool-call content block: `{"type": "tool_use", "id", "name", "input"}`.
+	ContentBlockToolUse = "tool_use"
+	// ContentBlockToolResult identifies a tool-result content block: `{"type": "tool_result", "tool_use_id", "content", "is_error"}`.
+	ContentBlockToolResult = "tool_result"
+)
+
+// NewToolUseBlock builds a canonical Lingua tool_use content block.
+func NewToolUseBlock(id, name string, input any) map[string]any {
+	return map[string]any{
+		"type":  ContentBlockToolUse,
+		"id":    id,
+		"name":  name,
+		"input": input,
+	}
+}
+
+// NewToolResultBlock builds a canonical Lingua tool_result content block.
+//
+// content holds the tool's output (a string or a slice of content blocks, mirroring
+// Anthropic's `tool_result.content`), and isError marks a failed tool invocation.
+func NewToolResultBlock(toolUseID string, content any, isError bool) map[string]any {
+	return map[string]any{
+		"type":        ContentBlockToolResult,
+		"tool_use_id": toolUseID,
+		"content":     content,
+		"is_error":    isError,
+	}
+}
@@ -0,0 +1,171 @@
+package lingua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatCompletionsToolCallsConversion(t *testing.T) {
+	chatMsgs := []map[string]any{
+		{
+			"role":    "assistant",
+			"content": nil,
+			"tool_calls": []map[string]any{
+				{
+					"id":   "call_1",
+					"type": "function",
+					"function": map[string]any{
+						"name":      "get_weather",
+						"arguments": `{"location":"SF"}`,
+					},
+				},
+			},
+		},
+		{
+			"role":         "tool",
+			"tool_call_id": "call_1",
+			"content":      "60F",
+		},
+	}
+
+	linguaMsgs, err := ChatCompletionsMessagesToLingua(chatMsgs)
+	require.NoError(t, err)
+	require.NotEmpty(t, linguaMsgs)
+
+	backToChat, err := LinguaToChatCompletionsMessages(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, backToChat, 2)
+	require.Equal(t, "tool", backToChat[1]["role"])
+	require.Equal(t, "call_1", backToChat[1]["tool_call_id"])
+}
+
+func TestAnthropicToolUseConversion(t *testing.T) {
+	anthropicMsgs := []map[string]any{
+		{
+			"role": "assistant",
+			"content": []map[string]any{
+				NewToolUseBlock("toolu_1", "get_weather", map[string]any{"location": "SF"}),
+			},
+		},
+		{
+			"role": "user",
+			"content": []map[string]any{
+				NewToolResultBlock("toolu_1", "60F", false),
+			},
+		},
+	}
+
+	linguaMsgs, err := AnthropicMessagesToLingua(anthropicMsgs)
+	require.NoError(t, err)
+	require.Len(t, linguaMsgs, 2)
+
+	backToAnthropic, err := LinguaToAnthropicMessages(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, backToAnthropic, 2)
+}
+
+// TestCrossProviderToolCallConversion exercises the shape mismatch between OpenAI's
+// separate `role:"tool"` messages and Anthropic's `tool_result` blocks embedded in a
+// `user` message: converting Chat Completions -> Lingua -> Anthropic must merge the
+// assistant tool_calls message and the following tool message into Anthropic's
+// assistant/user pair, and the reverse trip must split them back apart.
+func TestCrossProviderToolCallConversion(t *testing.T) {
+	chatMsgs := []map[string]any{
+		{
+			"role":    "assistant",
+			"content": nil,
+			"tool_calls": []map[string]any{
+				{
+					"id":   "call_1",
+					"type": "function",
+					"function": map[string]any{
+						"name":      "get_weather",
+						"arguments": `{"location":"SF"}`,
+					},
+				},
+			},
+		},
+		{
+			"role":         "tool",
+			"tool_call_id": "call_1",
+			"content":      "60F",
+		},
+	}
+
+	linguaMsgs, err := ChatCompletionsMessagesToLingua(chatMsgs)
+	require.NoError(t, err)
+
+	anthropicMsgs, err := LinguaToAnthropicMessages(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, anthropicMsgs, 2)
+	require.Equal(t, "assistant", anthropicMsgs[0]["role"])
+	require.Equal(t, "user", anthropicMsgs[1]["role"])
+
+	backToLingua, err := AnthropicMessagesToLingua(anthropicMsgs)
+	require.NoError(t, err)
+
+	backToChat, err := LinguaToChatCompletionsMessages(backToLingua)
+	require.NoError(t, err)
+	require.Len(t, backToChat, 2)
+	require.Equal(t, "tool", backToChat[1]["role"])
+}
+
+func TestLinguaToChatCompletionsPreservesTextAlongsideToolCalls(t *testing.T) {
+	linguaMsgs := []map[string]any{
+		{
+			"role": "assistant",
+			"content": []map[string]any{
+				{"type": "text", "text": "Let me check the weather."},
+				NewToolUseBlock("toolu_1", "get_weather", map[string]any{"location": "SF"}),
+			},
+		},
+	}
+
+	backToChat, err := LinguaToChatCompletionsMessages(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, backToChat, 1)
+	require.Equal(t, "Let me check the weather.", backToChat[0]["content"])
+	require.NotNil(t, backToChat[0]["tool_calls"])
+}
+
+func TestLinguaToChatCompletionsPreservesTextAlongsideToolResult(t *testing.T) {
+	linguaMsgs := []map[string]any{
+		{
+			"role": "user",
+			"content": []map[string]any{
+				{"type": "text", "text": "here's the result and a new request"},
+				NewToolResultBlock("toolu_1", "60F", false),
+			},
+		},
+	}
+
+	backToChat, err := LinguaToChatCompletionsMessages(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, backToChat, 2)
+	require.Equal(t, "user", backToChat[0]["role"])
+	require.Equal(t, "here's the result and a new request", backToChat[0]["content"])
+	require.Equal(t, "tool", backToChat[1]["role"])
+	require.Equal(t, "toolu_1", backToChat[1]["tool_call_id"])
+}
+
+func TestDeduplicateMessagesByToolUseID(t *testing.T) {
+	messages := []map[string]any{
+		{
+			"role": "assistant",
+			"content": []map[string]any{
+				NewToolUseBlock("toolu_1", "get_weather", map[string]any{"location": "SF"}),
+			},
+		},
+		{
+			"role": "assistant",
+			"content": []map[string]any{
+				NewToolUseBlock("toolu_1", "get_weather", map[string]any{"location": "SF"}),
+			},
+		},
+	}
+
+	deduplicated, err := DeduplicateMessages(messages)
+	require.NoError(t, err)
+	require.Len(t, deduplicated, 1)
+}
@@ -0,0 +1,285 @@
+package lingua
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dop251/goja"
+)
+
+// TransformFunc mutates a single Lingua message. Returning nil drops the message from
+// the pipeline; returning an error fails the whole Apply call.
+type TransformFunc func(msg map[string]any) (map[string]any, error)
+
+// MessagesTransformFunc operates on an entire message slice for one pipeline stage,
+// rather than one message at a time. Use this instead of TransformFunc when a stage
+// needs to add, reorder, or merge messages, which a 1-in/1-out TransformFunc cannot do.
+type MessagesTransformFunc func(messages []map[string]any) ([]map[string]any, error)
+
+// TransformSpec declaratively configures one stage of a transform pipeline.
+type TransformSpec struct {
+	// Name identifies a built-in or previously Registered transform when Engine is "go",
+	// or is purely a label (used in metrics) when Engine is "javascript".
+	Name string
+	// Engine selects how Source is interpreted: "go" dispatches to the named transform
+	// in the registry, "javascript" evaluates Source as a goja script.
+	Engine string
+	// Source holds the script body when Engine is "javascript"; unused for "go".
+	Source string
+	// Timeout bounds how long a single message may take to run through this stage; for
+	// a stage registered with RegisterMessagesTransform it bounds the whole stage call.
+	// Zero means no timeout.
+	Timeout time.Duration
+}
+
+// TransformMetrics accumulates per-transform pipeline statistics.
+type TransformMetrics struct {
+	Invocations int64
+	Drops       int64
+	Failures    int64
+	Latency     time.Duration
+}
+
+var (
+	transformRegistryMu sync.RWMutex
+	transformRegistry   = map[string]TransformFunc{}
+
+	messagesTransformRegistryMu sync.RWMutex
+	messagesTransformRegistry   = map[string]MessagesTransformFunc{}
+
+	transformMetricsMu sync.Mutex
+	transformMetrics   = map[string]*TransformMetrics{}
+)
+
+// RegisterTransform registers a native Go transform under name, making it available to
+// TransformSpec{Engine: "go", Name: name}.
+func RegisterTransform(name string, fn TransformFunc) {
+	transformRegistryMu.Lock()
+	defer transformRegistryMu.Unlock()
+	transformRegistry[name] = fn
+}
+
+func lookupTransform(name string) (TransformFunc, bool) {
+	transformRegistryMu.RLock()
+	defer transformRegistryMu.RUnlock()
+	fn, ok := transformRegistry[name]
+	return fn, ok
+}
+
+// RegisterMessagesTransform registers a whole-slice Go transform under name, making it
+// available to TransformSpec{Engine: "go", Name: name}. Prefer this over RegisterTransform
+// for stages that need to add, reorder, or merge messages rather than mutate one in place.
+func RegisterMessagesTransform(name string, fn MessagesTransformFunc) {
+	messagesTransformRegistryMu.Lock()
+	defer messagesTransformRegistryMu.Unlock()
+	messagesTransformRegistry[name] = fn
+}
+
+func lookupMessagesTransform(name string) (MessagesTransformFunc, bool) {
+	messagesTransformRegistryMu.RLock()
+	defer messagesTransformRegistryMu.RUnlock()
+	fn, ok := messagesTransformRegistry[name]
+	return fn, ok
+}
+
+// TransformMetricsFor returns a snapshot of the accumulated metrics for the given
+// transform name.
+func TransformMetricsFor(name string) TransformMetrics {
+	transformMetricsMu.Lock()
+	defer transformMetricsMu.Unlock()
+	if m, ok := transformMetrics[name]; ok {
+		return *m
+	}
+	return TransformMetrics{}
+}
+
+func recordTransformResult(name string, latency time.Duration, dropped bool, failed bool) {
+	drops := int64(0)
+	if dropped {
+		drops = 1
+	}
+	recordTransformBatchResult(name, latency, drops, failed)
+}
+
+// recordTransformBatchResult is like recordTransformResult, but takes the exact number
+// of messages a MessagesTransformFunc stage dropped rather than a single bool, since one
+// whole-slice invocation can drop more than one message at a time.
+func recordTransformBatchResult(name string, latency time.Duration, drops int64, failed bool) {
+	transformMetricsMu.Lock()
+	defer transformMetricsMu.Unlock()
+	m, ok := transformMetrics[name]
+	if !ok {
+		m = &TransformMetrics{}
+		transformMetrics[name] = m
+	}
+	m.Invocations++
+	m.Latency += latency
+	m.Drops += drops
+	if failed {
+		m.Failures++
+	}
+}
+
+// Apply runs messages through the pipeline described by specs, in order, dropping any
+// message a stage returns nil for and failing the whole call on the first stage error.
+func Apply(messages []map[string]any, specs []TransformSpec) ([]map[string]any, error) {
+	result := messages
+	for _, spec := range specs {
+		next, err := applyStage(result, spec)
+		if err != nil {
+			return nil, err
+		}
+		result = next
+	}
+	return result, nil
+}
+
+func applyStage(messages []map[string]any, spec TransformSpec) ([]map[string]any, error) {
+	if spec.Engine == "" || spec.Engine == "go" {
+		if fn, ok := lookupMessagesTransform(spec.Name); ok {
+			return runMessagesTransform(spec, fn, messages)
+		}
+	}
+
+	runner, err := stageRunner(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]map[string]any, 0, len(messages))
+	for _, msg := range messages {
+		result, err := runTransformWithTimeout(spec, runner, msg)
+		if err != nil {
+			return nil, fmt.Errorf("lingua: transform %q failed: %w", spec.Name, err)
+		}
+		if result == nil {
+			continue
+		}
+		out = append(out, result)
+	}
+	return out, nil
+}
+
+func runMessagesTransform(spec TransformSpec, fn MessagesTransformFunc, messages []map[string]any) ([]map[string]any, error) {
+	start := time.Now()
+	out, err := runMessagesTransformWithTimeout(spec, fn, messages)
+
+	drops := int64(0)
+	if err == nil && len(out) < len(messages) {
+		drops = int64(len(messages) - len(out))
+	}
+	recordTransformBatchResult(spec.Name, time.Since(start), drops, err != nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("lingua: transform %q failed: %w", spec.Name, err)
+	}
+	return out, nil
+}
+
+func runMessagesTransformWithTimeout(spec TransformSpec, fn MessagesTransformFunc, messages []map[string]any) ([]map[string]any, error) {
+	if spec.Timeout <= 0 {
+		return fn(messages)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), spec.Timeout)
+	defer cancel()
+
+	type outcome struct {
+		messages []map[string]any
+		err      error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		m, e := fn(messages)
+		done <- outcome{m, e}
+	}()
+
+	select {
+	case o := <-done:
+		return o.messages, o.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("transform timed out after %s", spec.Timeout)
+	}
+}
+
+func stageRunner(spec TransformSpec) (TransformFunc, error) {
+	switch spec.Engine {
+	case "", "go":
+		fn, ok := lookupTransform(spec.Name)
+		if !ok {
+			return nil, fmt.Errorf("lingua: no transform registered as %q", spec.Name)
+		}
+		return fn, nil
+	case "javascript":
+		return newScriptTransform(spec.Source), nil
+	default:
+		return nil, fmt.Errorf("lingua: unsupported transform engine %q", spec.Engine)
+	}
+}
+
+func runTransformWithTimeout(spec TransformSpec, fn TransformFunc, msg map[string]any) (result map[string]any, err error) {
+	start := time.Now()
+	defer func() {
+		recordTransformResult(spec.Name, time.Since(start), result == nil && err == nil, err != nil)
+	}()
+
+	if spec.Timeout <= 0 {
+		result, err = fn(msg)
+		return result, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), spec.Timeout)
+	defer cancel()
+
+	type outcome struct {
+		msg map[string]any
+		err error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		m, e := fn(msg)
+		done <- outcome{m, e}
+	}()
+
+	select {
+	case o := <-done:
+		return o.msg, o.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("transform timed out after %s", spec.Timeout)
+	}
+}
+
+// newScriptTransform adapts a JavaScript source body into a TransformFunc, evaluated
+// with goja. The script is expected to define a `transform(msg)` function that returns
+// the (possibly modified) message, or null/undefined to drop it.
+func newScriptTransform(source string) TransformFunc {
+	return func(msg map[string]any) (map[string]any, error) {
+		vm := goja.New()
+		if _, err := vm.RunString(source); err != nil {
+			return nil, fmt.Errorf("failed to load script: %w", err)
+		}
+
+		transformFn, ok := goja.AssertFunction(vm.Get("transform"))
+		if !ok {
+			return nil, fmt.Errorf("script does not define a transform(msg) function")
+		}
+
+		value, err := transformFn(goja.Undefined(), vm.ToValue(msg))
+		if err != nil {
+			return nil, fmt.Errorf("script execution failed: %w", err)
+		}
+
+		exported := value.Export()
+		if exported == nil {
+			return nil, nil
+		}
+
+		result, ok := exported.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("script transform must return an object or null")
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,170 @@
+package lingua
+
+import "strings"
+
+func init() {
+	RegisterTransform("redact_pii", RedactPII)
+	RegisterTransform("drop_empty", DropEmptyMessages)
+}
+
+// piiPatterns are the crude substring markers redacted by RedactPII. This built-in is
+// intentionally simple; pipe messages through a real PII detector first via a "go"
+// transform registered under a different name if stronger guarantees are needed.
+var piiPatterns = []string{"@"}
+
+// RedactPII replaces string content that looks like it contains an email address with
+// "[REDACTED]". Content may be a plain string or a block array; text blocks within a
+// block array are redacted in place, other block types are left untouched. It is
+// registered as the "redact_pii" built-in transform.
+func RedactPII(msg map[string]any) (map[string]any, error) {
+	switch content := msg["content"].(type) {
+	case string:
+		redacted, changed := redactPIIString(content)
+		if !changed {
+			return msg, nil
+		}
+		out := cloneMessage(msg)
+		out["content"] = redacted
+		return out, nil
+	case []any:
+		blocks, changed := redactPIIBlocks(content)
+		if !changed {
+			return msg, nil
+		}
+		out := cloneMessage(msg)
+		out["content"] = blocks
+		return out, nil
+	default:
+		return msg, nil
+	}
+}
+
+func redactPIIString(content string) (string, bool) {
+	for _, pattern := range piiPatterns {
+		if strings.Contains(content, pattern) {
+			return "[REDACTED]", true
+		}
+	}
+	return content, false
+}
+
+func redactPIIBlocks(blocks []any) ([]any, bool) {
+	changed := false
+	out := make([]any, len(blocks))
+	for i, b := range blocks {
+		block, ok := b.(map[string]any)
+		if !ok || block["type"] != "text" {
+			out[i] = b
+			continue
+		}
+		text, ok := block["text"].(string)
+		if !ok {
+			out[i] = b
+			continue
+		}
+		redacted, textChanged := redactPIIString(text)
+		if !textChanged {
+			out[i] = b
+			continue
+		}
+		changed = true
+		newBlock := cloneMessage(block)
+		newBlock["text"] = redacted
+		out[i] = newBlock
+	}
+	return out, changed
+}
+
+// RemapRole returns a transform that rewrites a message's role according to mapping,
+// leaving roles not present in mapping untouched.
+func RemapRole(mapping map[string]string) TransformFunc {
+	return func(msg map[string]any) (map[string]any, error) {
+		role, ok := msg["role"].(string)
+		if !ok {
+			return msg, nil
+		}
+		mapped, ok := mapping[role]
+		if !ok {
+			return msg, nil
+		}
+
+		out := cloneMessage(msg)
+		out["role"] = mapped
+		return out, nil
+	}
+}
+
+// InjectSystemPrompt returns a transform that prepends a synthetic system message ahead
+// of the existing messages. It is a MessagesTransformFunc rather than a TransformFunc
+// because adding a message, unlike mutating or dropping one, needs access to the whole
+// pipeline stage's input; register it with RegisterMessagesTransform to reach it from a
+// declarative TransformSpec.
+func InjectSystemPrompt(prompt string) MessagesTransformFunc {
+	return func(messages []map[string]any) ([]map[string]any, error) {
+		out := make([]map[string]any, 0, len(messages)+1)
+		out = append(out, map[string]any{"role": "system", "content": prompt})
+		out = append(out, messages...)
+		return out, nil
+	}
+}
+
+// DropEmptyMessages drops messages whose content is an empty string, nil, or an empty
+// slice/map. It is registered as the "drop_empty" built-in transform.
+func DropEmptyMessages(msg map[string]any) (map[string]any, error) {
+	content, ok := msg["content"]
+	if !ok {
+		return msg, nil
+	}
+
+	switch v := content.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+	case []any:
+		if len(v) == 0 {
+			return nil, nil
+		}
+	case []map[string]any:
+		if len(v) == 0 {
+			return nil, nil
+		}
+	case map[string]any:
+		if len(v) == 0 {
+			return nil, nil
+		}
+	}
+
+	return msg, nil
+}
+
+func cloneMessage(msg map[string]any) map[string]any {
+	out := make(map[string]any, len(msg))
+	for k, v := range msg {
+		out[k] = v
+	}
+	return out
+}
+
+// ImportAndDeduplicateMessages imports messages from spans and removes duplicates in
+// one call, running the resulting messages through transforms afterward so
+// span-imported traces can be normalized before the caller sees them.
+func ImportAndDeduplicateMessages(spans any, specs ...TransformSpec) ([]map[string]any, error) {
+	imported, err := ImportMessagesFromSpans(spans)
+	if err != nil {
+		return nil, err
+	}
+
+	deduplicated, err := DeduplicateMessages(imported.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(specs) == 0 {
+		return deduplicated, nil
+	}
+
+	return Apply(deduplicated, specs)
+}
@@ -0,0 +1,166 @@
+package lingua
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyBuiltinTransforms(t *testing.T) {
+	messages := []map[string]any{
+		{"role": "user", "content": "contact me at a@example.com"},
+		{"role": "user", "content": ""},
+		{"role": "assistant", "content": "Hi there"},
+	}
+
+	result, err := Apply(messages, []TransformSpec{
+		{Name: "redact_pii", Engine: "go"},
+		{Name: "drop_empty", Engine: "go"},
+	})
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	require.Equal(t, "[REDACTED]", result[0]["content"])
+}
+
+func TestRedactPIIBlockContent(t *testing.T) {
+	msg := map[string]any{
+		"role": "user",
+		"content": []any{
+			map[string]any{"type": "text", "text": "contact me at a@example.com"},
+			map[string]any{"type": "image", "image": "https://example.com/a@b.png"},
+		},
+	}
+
+	redacted, err := RedactPII(msg)
+	require.NoError(t, err)
+
+	blocks := redacted["content"].([]any)
+	require.Equal(t, "[REDACTED]", blocks[0].(map[string]any)["text"])
+	require.Equal(t, "https://example.com/a@b.png", blocks[1].(map[string]any)["image"])
+}
+
+func TestApplyRemapRole(t *testing.T) {
+	RegisterTransform("remap_developer_to_system", RemapRole(map[string]string{"developer": "system"}))
+
+	messages := []map[string]any{
+		{"role": "developer", "content": "be concise"},
+	}
+
+	result, err := Apply(messages, []TransformSpec{{Name: "remap_developer_to_system"}})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, "system", result[0]["role"])
+}
+
+func TestApplyInjectSystemPrompt(t *testing.T) {
+	RegisterMessagesTransform("inject_be_concise", InjectSystemPrompt("be concise"))
+
+	messages := []map[string]any{
+		{"role": "user", "content": "hi"},
+		{"role": "assistant", "content": "hello"},
+	}
+
+	result, err := Apply(messages, []TransformSpec{{Name: "inject_be_concise"}})
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	require.Equal(t, "system", result[0]["role"])
+	require.Equal(t, "be concise", result[0]["content"])
+	require.Equal(t, "user", result[1]["role"])
+	require.Equal(t, "assistant", result[2]["role"])
+}
+
+func TestApplyMessagesTransformTimeout(t *testing.T) {
+	RegisterMessagesTransform("slow_inject", func(messages []map[string]any) ([]map[string]any, error) {
+		time.Sleep(50 * time.Millisecond)
+		return messages, nil
+	})
+
+	_, err := Apply([]map[string]any{{"role": "user", "content": "hi"}}, []TransformSpec{
+		{Name: "slow_inject", Timeout: time.Millisecond},
+	})
+	require.Error(t, err)
+}
+
+func TestMessagesTransformMetricsCountEachDrop(t *testing.T) {
+	RegisterMessagesTransform("drop_all_but_first", func(messages []map[string]any) ([]map[string]any, error) {
+		if len(messages) == 0 {
+			return messages, nil
+		}
+		return messages[:1], nil
+	})
+
+	_, err := Apply([]map[string]any{
+		{"role": "user", "content": "a"},
+		{"role": "user", "content": "b"},
+		{"role": "user", "content": "c"},
+	}, []TransformSpec{{Name: "drop_all_but_first"}})
+	require.NoError(t, err)
+
+	metrics := TransformMetricsFor("drop_all_but_first")
+	require.Equal(t, int64(1), metrics.Invocations)
+	require.Equal(t, int64(2), metrics.Drops)
+}
+
+func TestApplyUnknownTransform(t *testing.T) {
+	_, err := Apply([]map[string]any{{"role": "user", "content": "hi"}}, []TransformSpec{{Name: "does_not_exist"}})
+	require.Error(t, err)
+}
+
+func TestApplyTimeout(t *testing.T) {
+	RegisterTransform("slow", func(msg map[string]any) (map[string]any, error) {
+		time.Sleep(50 * time.Millisecond)
+		return msg, nil
+	})
+
+	_, err := Apply([]map[string]any{{"role": "user", "content": "hi"}}, []TransformSpec{
+		{Name: "slow", Timeout: time.Millisecond},
+	})
+	require.Error(t, err)
+}
+
+func TestApplyJavaScriptTransform(t *testing.T) {
+	messages := []map[string]any{
+		{"role": "user", "content": "hello"},
+	}
+
+	result, err := Apply(messages, []TransformSpec{
+		{
+			Name:   "uppercase",
+			Engine: "javascript",
+			Source: `function transform(msg) { msg.content = msg.content.toUpperCase(); return msg; }`,
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, "HELLO", result[0]["content"])
+}
+
+func TestTransformMetrics(t *testing.T) {
+	RegisterTransform("metrics_probe", DropEmptyMessages)
+
+	_, err := Apply([]map[string]any{
+		{"role": "user", "content": ""},
+		{"role": "user", "content": "hi"},
+	}, []TransformSpec{{Name: "metrics_probe"}})
+	require.NoError(t, err)
+
+	metrics := TransformMetricsFor("metrics_probe")
+	require.Equal(t, int64(2), metrics.Invocations)
+	require.Equal(t, int64(1), metrics.Drops)
+}
+
+func TestImportAndDeduplicateMessagesWithTransforms(t *testing.T) {
+	spans := []map[string]any{
+		{
+			"input": []map[string]any{
+				{"role": "user", "content": "contact me at a@example.com"},
+			},
+		},
+	}
+
+	result, err := ImportAndDeduplicateMessages(spans, TransformSpec{Name: "redact_pii"})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, "[REDACTED]", result[0]["content"])
+}
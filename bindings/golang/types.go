@@ -0,0 +1,310 @@
+package lingua
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ContentPart is implemented by each typed Lingua content block variant (TextPart,
+// ImagePart, ToolCallPart, ToolResultPart, ThinkingPart). It mirrors the `type`
+// discriminator used by the Rust schema and by the map[string]any content blocks
+// accepted elsewhere in this package.
+type ContentPart interface {
+	partType() string
+}
+
+// TextPart is a plain text content block.
+type TextPart struct {
+	Text string `json:"text"`
+}
+
+func (TextPart) partType() string { return "text" }
+
+// ImagePart is an image content block, referencing a URL or base64-encoded payload.
+type ImagePart struct {
+	Image           string `json:"image"`
+	MediaType       string `json:"media_type"`
+	ProviderOptions any    `json:"provider_options,omitempty"`
+}
+
+func (ImagePart) partType() string { return "image" }
+
+// ToolCallPart is a tool-call content block, matching the map-based NewToolUseBlock shape.
+type ToolCallPart struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Input any    `json:"input"`
+}
+
+func (ToolCallPart) partType() string { return ContentBlockToolUse }
+
+// ToolResultPart is a tool-result content block, matching the map-based
+// NewToolResultBlock shape.
+type ToolResultPart struct {
+	ToolUseID string `json:"tool_use_id"`
+	Content   any    `json:"content"`
+	IsError   bool   `json:"is_error"`
+}
+
+func (ToolResultPart) partType() string { return ContentBlockToolResult }
+
+// ThinkingPart is an extended-thinking/reasoning content block.
+type ThinkingPart struct {
+	Thinking string `json:"thinking"`
+}
+
+func (ThinkingPart) partType() string { return "thinking" }
+
+// Message is a strongly typed Lingua message, offered as an alternative to the
+// map[string]any representation used by the rest of this package.
+type Message struct {
+	Role    string
+	Content []ContentPart
+	ID      *string
+
+	// idPresent records whether "id" appeared in the source JSON at all (even as
+	// null), since *string alone can't distinguish that from the key being absent.
+	// Set by UnmarshalJSON; zero-value Message literals leave it false, so
+	// constructing a Message in code and marshaling it omits "id" by default.
+	idPresent bool
+}
+
+// MarshalJSON serializes a Message the same way the map-based API does: each
+// ContentPart gets its discriminator "type" field injected alongside its own fields.
+// A single TextPart is emitted as a bare string, mirroring the asymmetry
+// UnmarshalJSON already accepts on the way in. "id" is omitted unless it was present
+// in the source JSON (even as null), matching the map-based API's own null-vs-absent
+// distinction instead of collapsing both into "omitted".
+func (m Message) MarshalJSON() ([]byte, error) {
+	var content any
+	if len(m.Content) == 1 {
+		if text, ok := m.Content[0].(TextPart); ok {
+			content = text.Text
+		}
+	}
+	if content == nil {
+		blocks := make([]map[string]any, 0, len(m.Content))
+		for _, part := range m.Content {
+			block, err := contentPartToMap(part)
+			if err != nil {
+				return nil, err
+			}
+			blocks = append(blocks, block)
+		}
+		content = blocks
+	}
+
+	out := map[string]any{"role": m.Role, "content": content}
+	if m.ID != nil {
+		out["id"] = *m.ID
+	} else if m.idPresent {
+		out["id"] = nil
+	}
+	return json.Marshal(out)
+}
+
+func contentPartToMap(part ContentPart) (map[string]any, error) {
+	data, err := json.Marshal(part)
+	if err != nil {
+		return nil, err
+	}
+
+	var block map[string]any
+	if err := json.Unmarshal(data, &block); err != nil {
+		return nil, err
+	}
+	block["type"] = part.partType()
+	return block, nil
+}
+
+// UnmarshalJSON parses a message whose `content` is either a bare string (a simple text
+// message) or an array of typed content blocks discriminated by their `type` field.
+func (m *Message) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Role    string          `json:"role"`
+		Content json.RawMessage `json:"content"`
+		ID      *string         `json:"id"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	m.Role = raw.Role
+	m.ID = raw.ID
+	m.idPresent = jsonHasKey(data, "id")
+
+	if len(raw.Content) == 0 || string(raw.Content) == "null" {
+		m.Content = nil
+		return nil
+	}
+
+	var asString string
+	if err := json.Unmarshal(raw.Content, &asString); err == nil {
+		m.Content = []ContentPart{TextPart{Text: asString}}
+		return nil
+	}
+
+	var rawBlocks []json.RawMessage
+	if err := json.Unmarshal(raw.Content, &rawBlocks); err != nil {
+		return fmt.Errorf("lingua: content must be a string or an array of blocks: %w", err)
+	}
+
+	parts := make([]ContentPart, 0, len(rawBlocks))
+	for _, rawBlock := range rawBlocks {
+		part, err := unmarshalContentPart(rawBlock)
+		if err != nil {
+			return err
+		}
+		parts = append(parts, part)
+	}
+	m.Content = parts
+	return nil
+}
+
+// jsonHasKey reports whether key is present in the top-level JSON object data,
+// regardless of its value (including null).
+func jsonHasKey(data []byte, key string) bool {
+	var generic map[string]json.RawMessage
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return false
+	}
+	_, ok := generic[key]
+	return ok
+}
+
+func unmarshalContentPart(data []byte) (ContentPart, error) {
+	var discriminator struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return nil, err
+	}
+
+	switch discriminator.Type {
+	case "text":
+		var p TextPart
+		err := json.Unmarshal(data, &p)
+		return p, err
+	case "image":
+		var p ImagePart
+		err := json.Unmarshal(data, &p)
+		return p, err
+	case ContentBlockToolUse:
+		var p ToolCallPart
+		err := json.Unmarshal(data, &p)
+		return p, err
+	case ContentBlockToolResult:
+		var p ToolResultPart
+		err := json.Unmarshal(data, &p)
+		return p, err
+	case "thinking":
+		var p ThinkingPart
+		err := json.Unmarshal(data, &p)
+		return p, err
+	default:
+		return nil, fmt.Errorf("lingua: unknown content block type %q", discriminator.Type)
+	}
+}
+
+// ChatCompletionsRequest is a typed wrapper around an OpenAI Chat Completions request.
+type ChatCompletionsRequest struct {
+	Model    string    `json:"model"`
+	Messages []Message `json:"messages"`
+}
+
+// AnthropicRequest is a typed wrapper around an Anthropic Messages API request.
+type AnthropicRequest struct {
+	Model     string    `json:"model"`
+	MaxTokens int       `json:"max_tokens"`
+	System    string    `json:"system,omitempty"`
+	Messages  []Message `json:"messages"`
+}
+
+// ResponsesRequest is a typed wrapper around an OpenAI Responses API request.
+type ResponsesRequest struct {
+	Model string    `json:"model"`
+	Input []Message `json:"input"`
+}
+
+func messagesToMaps(messages []Message) ([]map[string]any, error) {
+	maps := make([]map[string]any, 0, len(messages))
+	for _, m := range messages {
+		data, err := json.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		var mp map[string]any
+		if err := json.Unmarshal(data, &mp); err != nil {
+			return nil, err
+		}
+		maps = append(maps, mp)
+	}
+	return maps, nil
+}
+
+func mapsToMessages(maps []map[string]any) ([]Message, error) {
+	messages := make([]Message, 0, len(maps))
+	for _, mp := range maps {
+		data, err := json.Marshal(mp)
+		if err != nil {
+			return nil, err
+		}
+		var m Message
+		if err := json.Unmarshal(data, &m); err != nil {
+			return nil, err
+		}
+		messages = append(messages, m)
+	}
+	return messages, nil
+}
+
+// ChatCompletionsToLingua converts typed Chat Completions messages to typed Lingua messages.
+func ChatCompletionsToLingua(messages []Message) ([]Message, error) {
+	maps, err := messagesToMaps(messages)
+	if err != nil {
+		return nil, &ConversionError{Message: err.Error(), Provider: "Chat Completions"}
+	}
+	result, err := ChatCompletionsMessagesToLingua(maps)
+	if err != nil {
+		return nil, err
+	}
+	return mapsToMessages(result)
+}
+
+// LinguaToChatCompletions converts typed Lingua messages to typed Chat Completions messages.
+func LinguaToChatCompletions(messages []Message) ([]Message, error) {
+	maps, err := messagesToMaps(messages)
+	if err != nil {
+		return nil, &ConversionError{Message: err.Error(), Provider: "Chat Completions"}
+	}
+	result, err := LinguaToChatCompletionsMessages(maps)
+	if err != nil {
+		return nil, err
+	}
+	return mapsToMessages(result)
+}
+
+// AnthropicToLingua converts typed Anthropic messages to typed Lingua messages.
+func AnthropicToLingua(messages []Message) ([]Message, error) {
+	maps, err := messagesToMaps(messages)
+	if err != nil {
+		return nil, &ConversionError{Message: err.Error(), Provider: "Anthropic"}
+	}
+	result, err := AnthropicMessagesToLingua(maps)
+	if err != nil {
+		return nil, err
+	}
+	return mapsToMessages(result)
+}
+
+// LinguaToAnthropic converts typed Lingua messages to typed Anthropic messages.
+func LinguaToAnthropic(messages []Message) ([]Message, error) {
+	maps, err := messagesToMaps(messages)
+	if err != nil {
+		return nil, &ConversionError{Message: err.Error(), Provider: "Anthropic"}
+	}
+	result, err := LinguaToAnthropicMessages(maps)
+	if err != nil {
+		return nil, err
+	}
+	return mapsToMessages(result)
+}
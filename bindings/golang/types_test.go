@@ -0,0 +1,97 @@
+package lingua
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageMarshalUnmarshalTextPart(t *testing.T) {
+	msg := Message{Role: "user", Content: []ContentPart{TextPart{Text: "Hello"}}}
+
+	data, err := json.Marshal(msg)
+	require.NoError(t, err)
+
+	var decoded Message
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, "user", decoded.Role)
+	require.Len(t, decoded.Content, 1)
+	require.Equal(t, TextPart{Text: "Hello"}, decoded.Content[0])
+}
+
+func TestMessageUnmarshalStringContent(t *testing.T) {
+	var msg Message
+	require.NoError(t, json.Unmarshal([]byte(`{"role":"user","content":"Hello"}`), &msg))
+	require.Len(t, msg.Content, 1)
+	require.Equal(t, TextPart{Text: "Hello"}, msg.Content[0])
+}
+
+func TestMessageUnmarshalToolCallPart(t *testing.T) {
+	raw := `{"role":"assistant","content":[{"type":"tool_use","id":"t1","name":"get_weather","input":{"location":"SF"}}]}`
+
+	var msg Message
+	require.NoError(t, json.Unmarshal([]byte(raw), &msg))
+	require.Len(t, msg.Content, 1)
+
+	toolCall, ok := msg.Content[0].(ToolCallPart)
+	require.True(t, ok)
+	require.Equal(t, "t1", toolCall.ID)
+	require.Equal(t, "get_weather", toolCall.Name)
+}
+
+func TestMessageUnmarshalUnknownBlockType(t *testing.T) {
+	var msg Message
+	err := json.Unmarshal([]byte(`{"role":"user","content":[{"type":"bogus"}]}`), &msg)
+	require.Error(t, err)
+}
+
+func TestMessageMarshalOmitsIDWhenNeverPresent(t *testing.T) {
+	msg := Message{Role: "user", Content: []ContentPart{TextPart{Text: "Hello"}}}
+
+	data, err := json.Marshal(msg)
+	require.NoError(t, err)
+
+	var mp map[string]any
+	require.NoError(t, json.Unmarshal(data, &mp))
+	_, hasID := mp["id"]
+	require.False(t, hasID, "id should be omitted when never set, not serialized as null")
+}
+
+func TestMessageMarshalPreservesExplicitNullID(t *testing.T) {
+	var msg Message
+	require.NoError(t, json.Unmarshal([]byte(`{"role":"assistant","content":"Hi","id":null}`), &msg))
+
+	data, err := json.Marshal(msg)
+	require.NoError(t, err)
+
+	var mp map[string]any
+	require.NoError(t, json.Unmarshal(data, &mp))
+	id, hasID := mp["id"]
+	require.True(t, hasID, "an explicit null id in the source should round-trip as an explicit null")
+	require.Nil(t, id)
+}
+
+func TestChatCompletionsToLinguaTyped(t *testing.T) {
+	messages := []Message{
+		{Role: "user", Content: []ContentPart{TextPart{Text: "Hello"}}},
+	}
+
+	linguaMsgs, err := ChatCompletionsToLingua(messages)
+	require.NoError(t, err)
+	require.Len(t, linguaMsgs, 1)
+	require.Equal(t, "user", linguaMsgs[0].Role)
+}
+
+func TestAnthropicToLinguaAndBackTyped(t *testing.T) {
+	messages := []Message{
+		{Role: "user", Content: []ContentPart{TextPart{Text: "Hello"}}},
+	}
+
+	linguaMsgs, err := AnthropicToLingua(messages)
+	require.NoError(t, err)
+
+	backToAnthropic, err := LinguaToAnthropic(linguaMsgs)
+	require.NoError(t, err)
+	require.Len(t, backToAnthropic, 1)
+}